@@ -0,0 +1,256 @@
+package work
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	defaultAutoscaleInterval     = 10 * time.Second
+	defaultAutoscaleMaxLatency   = 5 * time.Second
+	defaultAutoscaleMaxIdleRatio = 0.5
+)
+
+// AutoscaleOptions make a worker pool scale its concurrency while it's started. Every Interval, the pool adds about
+// half as many workers as it has if jobs waited longer than MaxLatency while the workers were busy, and removes about a
+// quarter of them if they were idle more than MaxIdleRatio of the time. Workers aren't added while they're idle, since
+// jobs are then held back by something more workers won't help with, eg a rate limit.
+type AutoscaleOptions struct {
+	MinConcurrency uint          // The fewest workers to scale down to. Defaults to 1.
+	MaxConcurrency uint          // The most workers to scale up to. 0 means autoscaling is off.
+	Interval       time.Duration // How often to scale. Defaults to 10 seconds.
+	MaxLatency     time.Duration // How long the oldest job in the pool's queues can wait before scaling up. Defaults to 5 seconds.
+	MaxIdleRatio   float64       // The share of time the workers can spend idle before scaling down, from 0 to 1. Defaults to 0.5.
+}
+
+func (o AutoscaleOptions) validate() error {
+	if o.MaxConcurrency == 0 {
+		return nil
+	}
+	if o.MinConcurrency > o.MaxConcurrency {
+		return fmt.Errorf("work: autoscale min concurrency %d is more than max concurrency %d", o.MinConcurrency, o.MaxConcurrency)
+	}
+	if o.MaxIdleRatio < 0 || o.MaxIdleRatio > 1 {
+		return fmt.Errorf("work: autoscale max idle ratio %v isn't between 0 and 1", o.MaxIdleRatio)
+	}
+	return nil
+}
+
+type autoscaler struct {
+	wp   *WorkerPool
+	opts AutoscaleOptions
+
+	lastCheckedAt time.Time
+	lastBusyTimes map[string]time.Duration // by worker ID
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+func newAutoscaler(wp *WorkerPool, opts AutoscaleOptions) *autoscaler {
+	if opts.MinConcurrency == 0 {
+		opts.MinConcurrency = 1
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = defaultAutoscaleInterval
+	}
+	if opts.MaxLatency <= 0 {
+		opts.MaxLatency = defaultAutoscaleMaxLatency
+	}
+	if opts.MaxIdleRatio == 0 {
+		opts.MaxIdleRatio = defaultAutoscaleMaxIdleRatio
+	}
+
+	return &autoscaler{
+		wp:               wp,
+		opts:             opts,
+		lastBusyTimes:    make(map[string]time.Duration),
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+func (a *autoscaler) start() {
+	go a.loop()
+}
+
+func (a *autoscaler) stop() {
+	a.stopChan <- struct{}{}
+	<-a.doneStoppingChan
+}
+
+func (a *autoscaler) loop() {
+	a.lastCheckedAt = time.Now()
+
+	// Add a bit of jitter so that pools started together don't scale in lockstep.
+	timer := time.NewTimer(a.opts.Interval + time.Duration(rand.Int63n(int64(a.opts.Interval/10)+1)))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-a.stopChan:
+			a.doneStoppingChan <- struct{}{}
+			return
+		case <-timer.C:
+			if err := a.scale(); err != nil {
+				logError("autoscaler.scale", err)
+			}
+			timer.Reset(a.opts.Interval)
+		}
+	}
+}
+
+func (a *autoscaler) scale() error {
+	if a.wp.isDraining() {
+		return nil
+	}
+
+	latency, err := a.queueLatency()
+	if err != nil {
+		return err
+	}
+
+	current := a.wp.currentWorkers()
+	concurrency := a.nextConcurrency(uint(len(current)), latency, a.idleRatio(current, time.Now()))
+	if concurrency != uint(len(current)) {
+		a.wp.SetConcurrency(concurrency)
+	}
+	return nil
+}
+
+// idleRatio returns the share of time the workers spent idle since the last check.
+func (a *autoscaler) idleRatio(workers []*worker, now time.Time) float64 {
+	elapsed := now.Sub(a.lastCheckedAt)
+	a.lastCheckedAt = now
+
+	busyTimes := make(map[string]time.Duration, len(workers))
+	var busy time.Duration
+	for _, w := range workers {
+		busyTime := w.busyTime(now)
+		busyTimes[w.workerID] = busyTime
+		busy += busyTime - a.lastBusyTimes[w.workerID]
+	}
+	a.lastBusyTimes = busyTimes
+
+	if elapsed <= 0 || len(workers) == 0 {
+		return 0
+	}
+	idle := 1 - float64(busy)/float64(elapsed*time.Duration(len(workers)))
+	if idle < 0 {
+		return 0
+	}
+	return idle
+}
+
+// queueLatency returns how long the oldest job in the pool's queues, including groups, lanes and named queues, has
+// been waiting in any of its namespaces.
+func (a *autoscaler) queueLatency() (time.Duration, error) {
+	type jobQueue struct{ namespace, jobName string }
+	var queues []string
+	var withGroups []jobQueue
+	for _, ns := range a.wp.namespaces {
+		for _, jt := range a.wp.currentJobTypes() {
+			for queue := range a.wp.queues {
+				queues = append(queues, redisKeyJobsQueue(ns.namespace, jt.Name, queue))
+			}
+			if a.wp.queuesOnly {
+				continue
+			}
+			queues = append(queues, redisKeyJobs(ns.namespace, jt.Name))
+			for lane := range jt.Lanes {
+				queues = append(queues, redisKeyJobsLane(ns.namespace, jt.Name, lane))
+			}
+			withGroups = append(withGroups, jobQueue{ns.namespace, jt.Name})
+		}
+	}
+	if len(queues) == 0 {
+		return 0, nil
+	}
+
+	conn := a.wp.pool.Get()
+	defer conn.Close()
+
+	for _, q := range withGroups {
+		if err := conn.Send("LRANGE", redisKeyJobsGroups(q.namespace, q.jobName), 0, -1); err != nil {
+			return 0, err
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return 0, err
+	}
+	for _, q := range withGroups {
+		groups, err := redis.Strings(conn.Receive())
+		if err != nil {
+			return 0, err
+		}
+		for _, group := range groups {
+			queues = append(queues, redisKeyJobsGroup(q.namespace, q.jobName, group))
+		}
+	}
+
+	for _, queue := range queues {
+		if err := conn.Send("LINDEX", queue, -1); err != nil {
+			return 0, err
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return 0, err
+	}
+
+	var oldest int64
+	for range queues {
+		rawJSON, err := redis.Bytes(conn.Receive())
+		if err == redis.ErrNil {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		job, err := newJob(rawJSON, nil, nil)
+		if err != nil {
+			logError("autoscaler.queue_latency.new_job", err)
+			continue
+		}
+		if oldest == 0 || job.EnqueuedAt < oldest {
+			oldest = job.EnqueuedAt
+		}
+	}
+	if oldest == 0 {
+		return 0, nil
+	}
+
+	return time.Duration(nowEpochSeconds()-oldest) * time.Second, nil
+}
+
+func (a *autoscaler) nextConcurrency(current uint, latency time.Duration, idleRatio float64) uint {
+	next := current
+	if latency > a.opts.MaxLatency && idleRatio <= a.opts.MaxIdleRatio {
+		next += maxUint(1, current/2)
+	} else if latency <= a.opts.MaxLatency && idleRatio > a.opts.MaxIdleRatio {
+		next -= minUint(current, maxUint(1, current/4))
+	}
+
+	if next < a.opts.MinConcurrency {
+		next = a.opts.MinConcurrency
+	}
+	if next > a.opts.MaxConcurrency {
+		next = a.opts.MaxConcurrency
+	}
+	return next
+}
+
+func maxUint(a, b uint) uint {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minUint(a, b uint) uint {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,135 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoscalerNextConcurrency(t *testing.T) {
+	a := newAutoscaler(nil, AutoscaleOptions{MinConcurrency: 2, MaxConcurrency: 20})
+
+	var cases = []struct {
+		current   uint
+		latency   time.Duration
+		idleRatio float64
+		expected  uint
+	}{
+		{4, 10 * time.Second, 0.1, 6}, // jobs are waiting and workers are busy
+		{1, 10 * time.Second, 0.1, 2}, // scales up by at least 1
+		{16, 10 * time.Second, 0, 20}, // up to the max
+		{4, 10 * time.Second, 0.9, 4}, // jobs are waiting, but more workers wouldn't help
+		{8, time.Second, 0.9, 6},      // workers are idle
+		{3, time.Second, 0.9, 2},      // down to the min
+		{8, time.Second, 0.2, 8},      // just right
+		{30, 0, 0.2, 20},              // too many
+		{1, 0, 0.2, 2},                // too few
+	}
+
+	for i, c := range cases {
+		assert.EqualValues(t, c.expected, a.nextConcurrency(c.current, c.latency, c.idleRatio), "case %d", i)
+	}
+}
+
+func TestAutoscalerIdleRatio(t *testing.T) {
+	now := time.Now()
+	a := newAutoscaler(nil, AutoscaleOptions{MaxConcurrency: 2})
+	a.lastCheckedAt = now.Add(-10 * time.Second)
+
+	busy := &worker{workerID: "busy", busySince: now.Add(-time.Minute).UnixNano()}
+	idle := &worker{workerID: "idle", busyTotal: int64(time.Second)}
+	a.lastBusyTimes["busy"] = 50 * time.Second
+	a.lastBusyTimes["idle"] = time.Second
+
+	assert.InDelta(t, 0.5, a.idleRatio([]*worker{busy, idle}, now), 0.001)
+	assert.Equal(t, now, a.lastCheckedAt)
+	assert.Equal(t, time.Minute, a.lastBusyTimes["busy"])
+}
+
+func TestAutoscalerQueueLatency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("foo", func(*Job) error { return nil })
+	wp.JobWithOptions("bar", JobOptions{Lanes: map[string]uint{"high": 10}}, func(*Job) error { return nil })
+	a := newAutoscaler(wp, AutoscaleOptions{MaxConcurrency: 2})
+
+	latency, err := a.queueLatency()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, latency)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	setNowEpochSecondsMock(1425263390)
+	_, err = enqueuer.EnqueueForGroup("foo", "tenant1", nil)
+	assert.NoError(t, err)
+	setNowEpochSecondsMock(1425263400)
+	_, err = enqueuer.EnqueueToLane("bar", "high", nil)
+	assert.NoError(t, err)
+	setNowEpochSecondsMock(1425263405)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+
+	setNowEpochSecondsMock(1425263430)
+	defer resetNowEpochSecondsMock()
+	latency, err = a.queueLatency()
+	assert.NoError(t, err)
+	assert.Equal(t, 40*time.Second, latency)
+
+	// Once the group's queue is empty, the oldest job is in the lane.
+	_, err = NewClient(ns, pool).PurgeQueue("foo")
+	assert.NoError(t, err)
+	latency, err = a.queueLatency()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, latency)
+}
+
+func TestWorkerPoolAutoscale(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	deleteQueue(pool, ns, job1)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{
+		Autoscale: AutoscaleOptions{MaxConcurrency: 4, Interval: 10 * time.Millisecond, MaxLatency: time.Second},
+	})
+	wp.Job(job1, func(*Job) error {
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+
+	// Jobs that have been waiting for a long time while the worker is busy make the pool scale up.
+	enqueuer := NewEnqueuer(ns, pool)
+	setNowEpochSecondsMock(1425263400)
+	for i := 0; i < 40; i++ {
+		_, err := enqueuer.Enqueue(job1, nil)
+		assert.NoError(t, err)
+	}
+	resetNowEpochSecondsMock()
+
+	wp.Start()
+	assert.True(t, waitForConcurrency(wp, 4, time.Second))
+
+	// Once the queue is empty and the workers are idle, it scales back down.
+	wp.Drain()
+	assert.True(t, waitForConcurrency(wp, 1, time.Second))
+	wp.Stop()
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+}
+
+func waitForConcurrency(wp *WorkerPool, concurrency uint, timeout time.Duration) bool {
+	for start := time.Now(); time.Since(start) < timeout; time.Sleep(5 * time.Millisecond) {
+		if wp.Concurrency() == concurrency {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAutoscaleOptionsValidate(t *testing.T) {
+	assert.NoError(t, AutoscaleOptions{}.validate())
+	assert.NoError(t, AutoscaleOptions{MinConcurrency: 2, MaxConcurrency: 2}.validate())
+	assert.Error(t, AutoscaleOptions{MinConcurrency: 3, MaxConcurrency: 2}.validate())
+	assert.Error(t, AutoscaleOptions{MaxConcurrency: 2, MaxIdleRatio: 1.5}.validate())
+}
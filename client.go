@@ -1,10 +1,13 @@
 package work
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
@@ -13,6 +16,9 @@ import (
 // no object was actually deleted by those commmands.
 var ErrNotDeleted = fmt.Errorf("nothing deleted")
 
+// ErrScheduleNotFound is returned by functions that change periodic schedules if there's no schedule with the given ID.
+var ErrScheduleNotFound = fmt.Errorf("periodic schedule not found")
+
 // ErrNotRetried is returned by functions that retry jobs to indicate that although the redis commands were successful,
 // no object was actually retried by those commmands.
 var ErrNotRetried = fmt.Errorf("nothing retried")
@@ -41,6 +47,9 @@ type WorkerPoolHeartbeat struct {
 	Host         string   `json:"host"`
 	Pid          int      `json:"pid"`
 	WorkerIDs    []string `json:"worker_ids"`
+
+	Quiet  bool              `json:"quiet"`            // See WorkerPool.Quiet
+	Labels map[string]string `json:"labels,omitempty"` // See WorkerPoolOptions.Labels
 }
 
 // WorkerPoolHeartbeats queries Redis and returns all WorkerPoolHeartbeat's it finds (even for those worker pools which don't have a current heartbeat).
@@ -104,6 +113,10 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 			} else if key == "worker_ids" {
 				heartbeat.WorkerIDs = strings.Split(value, ",")
 				sort.Strings(heartbeat.WorkerIDs)
+			} else if key == "quiet" {
+				heartbeat.Quiet = value == "1"
+			} else if key == "labels" {
+				err = json.Unmarshal([]byte(value), &heartbeat.Labels)
 			}
 			if err != nil {
 				logError("worker_pool_statuses.parse", err)
@@ -117,6 +130,31 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	return heartbeats, nil
 }
 
+// Stats represents counters kept by the worker pools in a namespace.
+type Stats struct {
+	DeadJobsTrimmed  int64 `json:"dead_jobs_trimmed"`  // Dead jobs removed by a DeadJobRetention policy
+	NoOverlapSkipped int64 `json:"no_overlap_skipped"` // Jobs skipped because another job with JobOptions.NoOverlap was running
+	NoOverlapDelayed int64 `json:"no_overlap_delayed"` // Jobs rescheduled because another job with JobOptions.NoOverlap was running
+}
+
+// Stats returns the counters kept by the worker pools in the namespace.
+func (c *Client) Stats() (*Stats, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	vals, err := redis.Int64Map(conn.Do("HGETALL", redisKeyStats(c.namespace)))
+	if err != nil {
+		logError("client.stats.hgetall", err)
+		return nil, err
+	}
+
+	return &Stats{
+		DeadJobsTrimmed:  vals["dead_jobs_trimmed"],
+		NoOverlapSkipped: vals["no_overlap_skipped"],
+		NoOverlapDelayed: vals["no_overlap_delayed"],
+	}, nil
+}
+
 // WorkerObservation represents the latest observation taken from a worker. The observation indicates whether the worker is busy processing a job, and if so, information about that job.
 type WorkerObservation struct {
 	WorkerID string `json:"worker_id"`
@@ -203,10 +241,26 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 }
 
 // Queue represents a queue that holds jobs with the same name. It indicates their name, count, and latency (in seconds). Latency is a measurement of how long ago the next job to be processed was enqueued.
+// Each lane (see EnqueueToLane) and named queue (see EnqueueTo) of a job type is a queue of its own.
 type Queue struct {
-	JobName string `json:"job_name"`
-	Count   int64  `json:"count"`
-	Latency int64  `json:"latency"`
+	JobName   string          `json:"job_name"`
+	Lane      string          `json:"lane,omitempty"`
+	Queue     string          `json:"queue,omitempty"`
+	Priority  uint            `json:"priority,omitempty"` // The priority workers fetch the queue with, if a worker pool wrote it
+	Count     int64           `json:"count"`
+	Latency   int64           `json:"latency"`
+	Groups    int64           `json:"groups,omitempty"`     // The number of groups with jobs in the queue, see EnqueueForGroup. Count includes their jobs.
+	RateLimit *QueueRateLimit `json:"rate_limit,omitempty"` // Set if the job type has a JobOptions.RateLimit
+}
+
+// QueueRateLimit is the state of a queue's rate limit. Tokens is how many jobs can start right now. If it's below 1,
+// the queue is held back for HeldBackMs milliseconds until the next token comes in.
+type QueueRateLimit struct {
+	Limit      int64   `json:"limit"`
+	PerMs      int64   `json:"per_ms"`
+	Burst      int64   `json:"burst"`
+	Tokens     float64 `json:"tokens"`
+	HeldBackMs int64   `json:"held_back_ms"`
 }
 
 // Queues returns the Queue's it finds.
@@ -221,8 +275,13 @@ func (c *Client) Queues() ([]*Queue, error) {
 	}
 	sort.Strings(jobNames)
 
-	for _, jobName := range jobNames {
-		conn.Send("LLEN", redisKeyJobs(c.namespace, jobName))
+	queues, err := c.queuesWithLanes(conn, jobNames)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range queues {
+		conn.Send("LLEN", c.queueKey(s))
 	}
 
 	if err := conn.Flush(); err != nil {
@@ -230,26 +289,17 @@ func (c *Client) Queues() ([]*Queue, error) {
 		return nil, err
 	}
 
-	queues := make([]*Queue, 0, len(jobNames))
-
-	for _, jobName := range jobNames {
-		count, err := redis.Int64(conn.Receive())
+	for _, s := range queues {
+		s.Count, err = redis.Int64(conn.Receive())
 		if err != nil {
 			logError("client.queues.receive", err)
 			return nil, err
 		}
-
-		queue := &Queue{
-			JobName: jobName,
-			Count:   count,
-		}
-
-		queues = append(queues, queue)
 	}
 
 	for _, s := range queues {
 		if s.Count > 0 {
-			conn.Send("LINDEX", redisKeyJobs(c.namespace, s.JobName), -1)
+			conn.Send("LINDEX", c.queueKey(s), -1)
 		}
 	}
 
@@ -276,9 +326,427 @@ func (c *Client) Queues() ([]*Queue, error) {
 		}
 	}
 
+	// Groups and rate limits belong to the job type, so they're shown with its main queue.
+	mainQueues := make([]*Queue, 0, len(jobNames))
+	for _, s := range queues {
+		if s.Lane == "" && s.Queue == "" {
+			mainQueues = append(mainQueues, s)
+		}
+	}
+
+	if err := c.addGroupCounts(conn, mainQueues); err != nil {
+		return nil, err
+	}
+
+	for _, s := range mainQueues {
+		conn.Send("HMGET", redisKeyJobsRateLimit(c.namespace, s.JobName), "limit", "per", "burst", "tokens", "ts")
+	}
+
+	if err := conn.Flush(); err != nil {
+		logError("client.queues.flush3", err)
+		return nil, err
+	}
+
+	nowMs := nowEpochMilliseconds()
+
+	for _, s := range mainQueues {
+		values, err := redis.Values(conn.Receive())
+		if err != nil {
+			logError("client.queues.receive3", err)
+			return nil, err
+		}
+		s.RateLimit, err = newQueueRateLimit(values, nowMs)
+		if err != nil {
+			logError("client.queues.rate_limit", err)
+			return nil, err
+		}
+	}
+
+	return queues, nil
+}
+
+// queuesWithLanes returns a queue for each job name, followed by its lanes in order of priority and its named queues
+// in alphabetical order.
+func (c *Client) queuesWithLanes(conn redis.Conn, jobNames []string) ([]*Queue, error) {
+	for _, jobName := range jobNames {
+		conn.Send("GET", redisKeyJobsPriority(c.namespace, jobName))
+		conn.Send("HGETALL", redisKeyJobsLanes(c.namespace, jobName))
+		conn.Send("SMEMBERS", redisKeyJobsQueues(c.namespace, jobName))
+	}
+
+	if err := conn.Flush(); err != nil {
+		logError("client.queues.lanes.flush", err)
+		return nil, err
+	}
+
+	queues := make([]*Queue, 0, len(jobNames))
+	for _, jobName := range jobNames {
+		priority, err := redis.Int64(conn.Receive())
+		if err != nil && err != redis.ErrNil {
+			logError("client.queues.lanes.receive", err)
+			return nil, err
+		}
+		queues = append(queues, &Queue{JobName: jobName, Priority: uint(priority)})
+
+		lanes, err := redis.Int64Map(conn.Receive())
+		if err != nil {
+			logError("client.queues.lanes.receive2", err)
+			return nil, err
+		}
+		laneQueues := make([]*Queue, 0, len(lanes))
+		for lane, lanePriority := range lanes {
+			laneQueues = append(laneQueues, &Queue{JobName: jobName, Lane: lane, Priority: uint(lanePriority)})
+		}
+		sort.Slice(laneQueues, func(i, j int) bool {
+			if laneQueues[i].Priority != laneQueues[j].Priority {
+				return laneQueues[i].Priority > laneQueues[j].Priority
+			}
+			return laneQueues[i].Lane < laneQueues[j].Lane
+		})
+		queues = append(queues, laneQueues...)
+
+		namedQueues, err := redis.Strings(conn.Receive())
+		if err != nil {
+			logError("client.queues.lanes.receive3", err)
+			return nil, err
+		}
+		sort.Strings(namedQueues)
+		for _, queue := range namedQueues {
+			queues = append(queues, &Queue{JobName: jobName, Queue: queue})
+		}
+	}
+
 	return queues, nil
 }
 
+func (c *Client) queueKey(q *Queue) string {
+	if q.Lane != "" {
+		return redisKeyJobsLane(c.namespace, q.JobName, q.Lane)
+	}
+	if q.Queue != "" {
+		return redisKeyJobsQueue(c.namespace, q.JobName, q.Queue)
+	}
+	return redisKeyJobs(c.namespace, q.JobName)
+}
+
+// addGroupCounts adds the jobs in the queues of each job type's groups to the count of its queue.
+func (c *Client) addGroupCounts(conn redis.Conn, queues []*Queue) error {
+	for _, s := range queues {
+		conn.Send("LRANGE", redisKeyJobsGroups(c.namespace, s.JobName), 0, -1)
+	}
+
+	if err := conn.Flush(); err != nil {
+		logError("client.queues.groups.flush", err)
+		return err
+	}
+
+	groups := make([][]string, len(queues))
+	for i := range queues {
+		var err error
+		groups[i], err = redis.Strings(conn.Receive())
+		if err != nil {
+			logError("client.queues.groups.receive", err)
+			return err
+		}
+		queues[i].Groups = int64(len(groups[i]))
+	}
+
+	for i, s := range queues {
+		for _, group := range groups[i] {
+			conn.Send("LLEN", redisKeyJobsGroup(c.namespace, s.JobName, group))
+		}
+	}
+
+	if err := conn.Flush(); err != nil {
+		logError("client.queues.groups.flush2", err)
+		return err
+	}
+
+	for i, s := range queues {
+		for range groups[i] {
+			count, err := redis.Int64(conn.Receive())
+			if err != nil {
+				logError("client.queues.groups.receive2", err)
+				return err
+			}
+			s.Count += count
+		}
+	}
+
+	return nil
+}
+
+// newQueueRateLimit refills the token bucket like the fetch script does. It returns nil if the queue isn't rate limited.
+func newQueueRateLimit(values []interface{}, nowMs int64) (*QueueRateLimit, error) {
+	var limit, per, burst int64
+	var tokens, ts float64 = -1, -1
+	if _, err := redis.Scan(values, &limit, &per, &burst, &tokens, &ts); err != nil {
+		return nil, err
+	}
+	if limit == 0 || per == 0 {
+		return nil, nil
+	}
+
+	if tokens < 0 {
+		tokens = float64(burst)
+	}
+	if ts >= 0 && float64(nowMs) > ts {
+		tokens += (float64(nowMs) - ts) * float64(limit) / float64(per)
+	}
+	if tokens > float64(burst) {
+		tokens = float64(burst)
+	}
+
+	rl := &QueueRateLimit{
+		Limit:  limit,
+		PerMs:  per,
+		Burst:  burst,
+		Tokens: tokens,
+	}
+	if tokens < 1 {
+		rl.HeldBackMs = int64(math.Ceil((1 - tokens) * float64(per) / float64(limit)))
+	}
+	return rl, nil
+}
+
+// QueuedJobs returns a list of the Job's waiting in the jobName queue, jobs without a group first, followed by the jobs of each group (see EnqueueForGroup), a group at a time. Workers take turns between the jobs without a group and each group, so that isn't quite the order they'll be processed in. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the queue, including its groups, is also returned.
+func (c *Client) QueuedJobs(jobName string, page uint) ([]*Job, int64, error) {
+	return c.QueuedJobsIn(&Queue{JobName: jobName}, page)
+}
+
+// QueuedJobsIn is like QueuedJobs, but for the lane of a job type if q.Lane is set (see EnqueueToLane), or for one of its named queues if q.Queue is (see EnqueueTo). Only q.JobName, q.Lane and q.Queue are used.
+func (c *Client) QueuedJobsIn(q *Queue, page uint) ([]*Job, int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if page == 0 {
+		page = 1
+	}
+
+	keys, _, err := c.queuedJobLists(conn, q)
+	if err != nil {
+		logError("client.queued_jobs.lists", err)
+		return nil, 0, err
+	}
+
+	for _, key := range keys {
+		conn.Send("LLEN", key)
+	}
+	if err := conn.Flush(); err != nil {
+		logError("client.queued_jobs.flush", err)
+		return nil, 0, err
+	}
+	lens := make([]int64, len(keys))
+	var count int64
+	for i := range keys {
+		if lens[i], err = redis.Int64(conn.Receive()); err != nil {
+			logError("client.queued_jobs.llen", err)
+			return nil, 0, err
+		}
+		count += lens[i]
+	}
+
+	// Jobs are LPUSH'd and popped off the right, so the next job to be processed in each list lives at index -1.
+	// The page spans the lists back to back.
+	start := int64(page-1) * 20
+	end := start + 20
+	jobs := make([]*Job, 0, 20)
+	var offset int64
+	for i, key := range keys {
+		from, to := start-offset, end-offset
+		offset += lens[i]
+		if to <= 0 || from >= lens[i] {
+			continue
+		}
+		if from < 0 {
+			from = 0
+		}
+		values, err := redis.ByteSlices(conn.Do("LRANGE", key, -to, -from-1))
+		if err != nil {
+			logError("client.queued_jobs.lrange", err)
+			return nil, 0, err
+		}
+
+		for j := len(values) - 1; j >= 0; j-- {
+			job, err := newJob(values[j], nil, nil)
+			if err != nil {
+				logError("client.queued_jobs.new_job", err)
+				return nil, 0, err
+			}
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs, count, nil
+}
+
+// queuedJobLists returns the lists that hold the jobs waiting in q. A lane or a named queue is a single list, while a
+// job queue is followed by the queues of its groups in the order the groups take turns. The names of the groups are
+// returned as well.
+func (c *Client) queuedJobLists(conn redis.Conn, q *Queue) ([]string, []string, error) {
+	if q.Lane != "" || q.Queue != "" {
+		return []string{c.queueKey(q)}, nil, nil
+	}
+
+	groups, err := redis.Strings(conn.Do("LRANGE", redisKeyJobsGroups(c.namespace, q.JobName), 0, -1))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Groups take turns from the right of the list.
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+
+	keys := make([]string, 0, 1+len(groups))
+	keys = append(keys, redisKeyJobs(c.namespace, q.JobName))
+	for _, group := range groups {
+		keys = append(keys, redisKeyJobsGroup(c.namespace, q.JobName, group))
+	}
+	return keys, groups, nil
+}
+
+// DeleteQueuedJob deletes a job waiting in the jobName queue or in one of its groups. If the job is unique, its unique key is deleted as well so that it can be enqueued again.
+func (c *Client) DeleteQueuedJob(jobName, jobID string) error {
+	return c.DeleteQueuedJobIn(&Queue{JobName: jobName}, jobID)
+}
+
+// DeleteQueuedJobIn is like DeleteQueuedJob, but for the lane or named queue of a job type if q.Lane or q.Queue is set. Only q.JobName, q.Lane and q.Queue are used.
+func (c *Client) DeleteQueuedJobIn(q *Queue, jobID string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	keys, groups, err := c.queuedJobLists(conn, q)
+	if err != nil {
+		logError("client.delete_queued_job.lists", err)
+		return err
+	}
+
+	script := redis.NewScript(2, redisLuaDeleteQueuedCmd)
+
+	// The lists are scanned here rather than in a script, a chunk at a time, so that a long queue doesn't block redis.
+	// Workers pop jobs off the right and enqueuers push them on the left, which only moves the jobs still to be
+	// scanned further right.
+	for i, key := range keys {
+		group := ""
+		if i > 0 {
+			group = groups[i-1]
+		}
+
+		for start := 0; ; start += queueScanBatchSize {
+			values, err := redis.ByteSlices(conn.Do("LRANGE", key, start, start+queueScanBatchSize-1))
+			if err != nil {
+				logError("client.delete_queued_job.lrange", err)
+				return err
+			}
+
+			for _, rawJSON := range values {
+				job, err := newJob(rawJSON, nil, nil)
+				if err != nil {
+					logError("client.delete_queued_job.new_job", err)
+					return err
+				}
+				if job.ID != jobID {
+					continue
+				}
+
+				args := make([]interface{}, 0, 2+2)
+				args = append(args, key)                                        // KEY[1]
+				args = append(args, redisKeyJobsGroups(c.namespace, q.JobName)) // KEY[2]
+				args = append(args, rawJSON)                                    // ARGV[1]
+				args = append(args, group)                                      // ARGV[2]
+
+				cnt, err := redis.Int64(script.Do(conn, args...))
+				if err != nil {
+					logError("client.delete_queued_job.do", err)
+					return err
+				}
+				if cnt == 0 {
+					return ErrNotDeleted // a worker got to it first
+				}
+				return nil
+			}
+
+			if len(values) < queueScanBatchSize {
+				break
+			}
+		}
+	}
+
+	return ErrNotDeleted
+}
+
+// PurgeQueue deletes all jobs waiting in the jobName queue and in its groups, along with the unique keys of any unique jobs among them. Jobs that are already in progress are not affected. It returns the number of jobs that were deleted.
+func (c *Client) PurgeQueue(jobName string) (int64, error) {
+	return c.PurgeQueueIn(&Queue{JobName: jobName})
+}
+
+// PurgeQueueIn is like PurgeQueue, but for the lane or named queue of a job type if q.Lane or q.Queue is set. Only q.JobName, q.Lane and q.Queue are used.
+func (c *Client) PurgeQueueIn(q *Queue) (int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	keys, groups, err := c.queuedJobLists(conn, q)
+	if err != nil {
+		logError("client.purge_queue.lists", err)
+		return 0, err
+	}
+
+	script := redis.NewScript(2, redisLuaPurgeQueueCmd)
+
+	var purged int64
+	for i, key := range keys {
+		group := ""
+		if i > 0 {
+			group = groups[i-1]
+		}
+
+		args := make([]interface{}, 0, 2+2)
+		args = append(args, key)                                        // KEY[1]
+		args = append(args, redisKeyJobsGroups(c.namespace, q.JobName)) // KEY[2]
+		args = append(args, 1000)                                       // ARGV[1]
+		args = append(args, group)                                      // ARGV[2]
+
+		// Each run deletes up to 1000 jobs off the end of the list, so keep going until it's empty.
+		for {
+			res, err := redis.Int64(script.Do(conn, args...))
+			if err != nil {
+				logError("client.purge_queue.do", err)
+				return purged, err
+			}
+
+			if res == 0 {
+				break
+			}
+			purged += res
+		}
+	}
+
+	return purged, nil
+}
+
+// DeleteLane removes a lane of the jobName job type, eg after it's been taken out of JobOptions.Lanes. Worker pools only ever add lanes, so that pools with different lanes don't remove each other's. Jobs waiting in the lane are moved onto the job type's queue, behind the jobs already there, and the number of moved jobs is returned. Worker pools that still have the lane in their JobOptions add it back when they start.
+func (c *Client) DeleteLane(jobName, lane string) (int64, error) {
+	script := redis.NewScript(3, redisLuaDeleteLaneCmd)
+
+	args := make([]interface{}, 0, 3+1)
+	args = append(args, redisKeyJobsLanes(c.namespace, jobName))      // KEY[1]
+	args = append(args, redisKeyJobsLane(c.namespace, jobName, lane)) // KEY[2]
+	args = append(args, redisKeyJobs(c.namespace, jobName))           // KEY[3]
+	args = append(args, lane)                                         // ARGV[1]
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	moved, err := redis.Int64(script.Do(conn, args...))
+	if err != nil {
+		logError("client.delete_lane.do", err)
+		return 0, err
+	}
+
+	return moved, nil
+}
+
 // RetryJob represents a job in the retry queue.
 type RetryJob struct {
 	RetryAt int64 `json:"retry_at"`
@@ -297,10 +765,79 @@ type DeadJob struct {
 	*Job
 }
 
-// ScheduledJobs returns a list of ScheduledJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of scheduled jobs is also returned.
+// JobFilter narrows down the jobs returned from the scheduled, retry, and dead queues. The zero value matches every job. Filtering on JobName, ErrorContains or ArgKey means decoding jobs, so those filters only look at the first 10000 jobs in the score range.
+type JobFilter struct {
+	JobName       string // Only match jobs with this name.
+	ErrorContains string // Only match jobs whose last error contains this substring.
+	ArgKey        string // Only match jobs that have this argument. If ArgValue is also set, the argument must format (via fmt.Sprint) to ArgValue.
+	ArgValue      string
+	Since         int64 // Only match jobs scored (run at, retry at, or died at) at or after this epoch second. 0 means no lower bound.
+	Until         int64 // Only match jobs scored at or before this epoch second. 0 means no upper bound.
+
+	Page     uint // 1-based page to return.
+	PageSize uint // Number of items per page. Defaults to 20, and can't be more than 100.
+}
+
+// maxJobFilterPageSize caps JobFilter.PageSize, so that a single request can't pull a whole queue out of redis.
+const maxJobFilterPageSize = 100
+
+func (f *JobFilter) matchesJobs() bool {
+	return f.JobName != "" || f.ErrorContains != "" || f.ArgKey != ""
+}
+
+func (f *JobFilter) matches(job *Job) bool {
+	if f.JobName != "" && job.Name != f.JobName {
+		return false
+	}
+	if f.ErrorContains != "" && !strings.Contains(job.LastErr, f.ErrorContains) {
+		return false
+	}
+	if f.ArgKey != "" {
+		v, ok := job.Args[f.ArgKey]
+		if !ok {
+			return false
+		}
+		if f.ArgValue != "" && fmt.Sprint(v) != f.ArgValue {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *JobFilter) scoreRange() (interface{}, interface{}) {
+	var min, max interface{} = "-inf", "+inf"
+	if f.Since != 0 {
+		min = f.Since
+	}
+	if f.Until != 0 {
+		max = f.Until
+	}
+	return min, max
+}
+
+func (f *JobFilter) pageBounds() (uint, uint) {
+	page, pageSize := f.Page, f.PageSize
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = 20
+	}
+	if pageSize > maxJobFilterPageSize {
+		pageSize = maxJobFilterPageSize
+	}
+	return (page - 1) * pageSize, pageSize
+}
+
+// ScheduledJobs returns a list of ScheduledJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of scheduled jobs is also returned. It's FilteredScheduledJobs without a filter.
 func (c *Client) ScheduledJobs(page uint) ([]*ScheduledJob, int64, error) {
+	return c.FilteredScheduledJobs(&JobFilter{Page: page})
+}
+
+// FilteredScheduledJobs returns the page of ScheduledJob's matching filter. The total number of matching items (not pages) is also returned.
+func (c *Client) FilteredScheduledJobs(filter *JobFilter) ([]*ScheduledJob, int64, error) {
 	key := redisKeyScheduled(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
+	jobsWithScores, count, err := c.getZsetPage(key, filter)
 	if err != nil {
 		logError("client.scheduled_jobs.get_zset_page", err)
 		return nil, 0, err
@@ -315,10 +852,15 @@ func (c *Client) ScheduledJobs(page uint) ([]*ScheduledJob, int64, error) {
 	return jobs, count, nil
 }
 
-// RetryJobs returns a list of RetryJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of retry jobs is also returned.
+// RetryJobs returns a list of RetryJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of retry jobs is also returned. It's FilteredRetryJobs without a filter.
 func (c *Client) RetryJobs(page uint) ([]*RetryJob, int64, error) {
+	return c.FilteredRetryJobs(&JobFilter{Page: page})
+}
+
+// FilteredRetryJobs returns the page of RetryJob's matching filter. The total number of matching items (not pages) is also returned.
+func (c *Client) FilteredRetryJobs(filter *JobFilter) ([]*RetryJob, int64, error) {
 	key := redisKeyRetry(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
+	jobsWithScores, count, err := c.getZsetPage(key, filter)
 	if err != nil {
 		logError("client.retry_jobs.get_zset_page", err)
 		return nil, 0, err
@@ -333,10 +875,15 @@ func (c *Client) RetryJobs(page uint) ([]*RetryJob, int64, error) {
 	return jobs, count, nil
 }
 
-// DeadJobs returns a list of DeadJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of dead jobs is also returned.
+// DeadJobs returns a list of DeadJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of dead jobs is also returned. It's FilteredDeadJobs without a filter.
 func (c *Client) DeadJobs(page uint) ([]*DeadJob, int64, error) {
+	return c.FilteredDeadJobs(&JobFilter{Page: page})
+}
+
+// FilteredDeadJobs returns the page of DeadJob's matching filter. The total number of matching items (not pages) is also returned.
+func (c *Client) FilteredDeadJobs(filter *JobFilter) ([]*DeadJob, int64, error) {
 	key := redisKeyDead(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
+	jobsWithScores, count, err := c.getZsetPage(key, filter)
 	if err != nil {
 		logError("client.dead_jobs.get_zset_page", err)
 		return nil, 0, err
@@ -465,15 +1012,412 @@ func (c *Client) DeleteAllDeadJobs() error {
 	return nil
 }
 
-// DeleteScheduledJob deletes a job in the scheduled queue.
-func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
-	ok, jobBytes, err := c.deleteZsetJob(redisKeyScheduled(c.namespace), scheduledFor, jobID)
+// RetryDeadJobs requeues all dead jobs matching filter. The filter's paging fields are ignored. It returns the number of jobs that were requeued.
+func (c *Client) RetryDeadJobs(filter *JobFilter) (int64, error) {
+	jobNames, err := c.knownJobNames()
 	if err != nil {
-		return err
+		logError("client.retry_dead_jobs.known_job_names", err)
+		return 0, err
 	}
 
-	// If we get a job back, parse it and see if it's a unique job. If it is, we need to delete the unique key.
-	if len(jobBytes) > 0 {
+	script := redis.NewScript(len(jobNames)+1, redisLuaRequeueDeadMembersCmd)
+
+	var requeued int64
+	err = c.eachFilteredZsetBatch(redisKeyDead(c.namespace), filter, func(conn redis.Conn, members [][]byte) error {
+		args := make([]interface{}, 0, len(jobNames)+1+2+len(members))
+		args = append(args, redisKeyDead(c.namespace)) // KEY[1]
+		for _, jobName := range jobNames {
+			args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
+		}
+		args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
+		args = append(args, nowEpochSeconds())               // ARGV[2]
+		for _, m := range members {
+			args = append(args, m) // ARGV[3, 4, ...]
+		}
+
+		cnt, err := redis.Int64(script.Do(conn, args...))
+		if err != nil {
+			return err
+		}
+		requeued += cnt
+		return nil
+	})
+	if err != nil {
+		logError("client.retry_dead_jobs.do", err)
+		return requeued, err
+	}
+
+	return requeued, nil
+}
+
+// DeleteDeadJobs deletes all dead jobs matching filter. The filter's paging fields are ignored. It returns the number of jobs that were deleted.
+func (c *Client) DeleteDeadJobs(filter *JobFilter) (int64, error) {
+	key := redisKeyDead(c.namespace)
+
+	var deleted int64
+	err := c.eachFilteredZsetBatch(key, filter, func(conn redis.Conn, members [][]byte) error {
+		args := make([]interface{}, 0, 1+len(members))
+		args = append(args, key)
+		for _, m := range members {
+			args = append(args, m)
+		}
+
+		cnt, err := redis.Int64(conn.Do("ZREM", args...))
+		if err != nil {
+			return err
+		}
+		deleted += cnt
+		return nil
+	})
+	if err != nil {
+		logError("client.delete_dead_jobs.do", err)
+		return deleted, err
+	}
+
+	return deleted, nil
+}
+
+// RetryNow moves a job in the retry queue onto the normal work queue so that it's processed right away instead of at retryAt.
+func (c *Client) RetryNow(retryAt int64, jobID string) error {
+	return c.enqueueZsetJobNow(redisKeyRetry(c.namespace), retryAt, jobID)
+}
+
+// RunScheduledNow moves a job in the scheduled queue onto the normal work queue so that it's processed right away instead of at runAt.
+func (c *Client) RunScheduledNow(runAt int64, jobID string) error {
+	return c.enqueueZsetJobNow(redisKeyScheduled(c.namespace), runAt, jobID)
+}
+
+// RetryAllRetryJobs moves all jobs in the retry queue onto the normal work queue so that they're processed right away.
+func (c *Client) RetryAllRetryJobs() error {
+	return c.enqueueAllZsetJobsNow(redisKeyRetry(c.namespace))
+}
+
+// RunAllScheduledJobs moves all jobs in the scheduled queue onto the normal work queue so that they're processed right away.
+func (c *Client) RunAllScheduledJobs() error {
+	return c.enqueueAllZsetJobsNow(redisKeyScheduled(c.namespace))
+}
+
+func (c *Client) enqueueZsetJobNow(zsetKey string, zscore int64, jobID string) error {
+	jobNames, err := c.knownJobNames()
+	if err != nil {
+		logError("client.enqueue_zset_job_now.known_job_names", err)
+		return err
+	}
+
+	script := redis.NewScript(len(jobNames)+1, redisLuaEnqueueSingleZsetCmd)
+
+	args := make([]interface{}, 0, len(jobNames)+1+4)
+	args = append(args, zsetKey) // KEY[1]
+	for _, jobName := range jobNames {
+		args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
+	}
+	args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
+	args = append(args, nowEpochSeconds())
+	args = append(args, zscore)
+	args = append(args, jobID)
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	cnt, err := redis.Int64(script.Do(conn, args...))
+	if err != nil {
+		logError("client.enqueue_zset_job_now.do", err)
+		return err
+	}
+
+	if cnt == 0 {
+		return ErrNotRetried
+	}
+
+	return nil
+}
+
+func (c *Client) enqueueAllZsetJobsNow(zsetKey string) error {
+	jobNames, err := c.knownJobNames()
+	if err != nil {
+		logError("client.enqueue_all_zset_jobs_now.known_job_names", err)
+		return err
+	}
+
+	script := redis.NewScript(len(jobNames)+2, redisLuaEnqueueAllZsetCmd)
+
+	args := make([]interface{}, 0, len(jobNames)+2+3)
+	args = append(args, zsetKey)                   // KEY[1]
+	args = append(args, redisKeyDead(c.namespace)) // KEY[2]
+	for _, jobName := range jobNames {
+		args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[3, 4, ...]
+	}
+	args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
+	args = append(args, nowEpochSeconds())
+	args = append(args, 1000)
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	// Cap iterations for safety, the same way RetryAllDeadJobs does.
+	for i := 0; i < 1000; i++ {
+		res, err := redis.Int64(script.Do(conn, args...))
+		if err != nil {
+			logError("client.enqueue_all_zset_jobs_now.do", err)
+			return err
+		}
+
+		if res == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) knownJobNames() ([]string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(jobNames)
+
+	return jobNames, nil
+}
+
+// PeriodicSchedules returns all periodic schedules stored in redis along with their last and next fire times.
+func (c *Client) PeriodicSchedules() ([]*PeriodicSchedule, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	schedules, err := getPeriodicSchedules(conn, c.namespace)
+	if err != nil {
+		logError("client.periodic_schedules.get", err)
+		return nil, err
+	}
+
+	periodicJobs := make([]*periodicJob, len(schedules))
+	for i, s := range schedules {
+		periodicJobs[i] = &periodicJob{scheduleID: s.ID}
+	}
+
+	fireTimes, err := getPeriodicFireTimes(conn, c.namespace, periodicJobs)
+	if err != nil {
+		logError("client.periodic_schedules.fire_times", err)
+		return nil, err
+	}
+
+	now := nowEpochSeconds()
+	for i, s := range schedules {
+		s.LastFireAt = fireTimes[i].lastAt(now)
+		if s.Disabled {
+			continue
+		}
+		if schedule, err := parsePeriodicSpec(s.Spec, nil); err == nil {
+			if next := schedule.Next(time.Unix(now, 0)); !next.IsZero() {
+				s.NextFireAt = next.Unix()
+			}
+		}
+	}
+
+	return schedules, nil
+}
+
+// AddPeriodicSchedule stores a new periodic schedule which enqueues jobName with args according to the cron-based spec,
+// see WorkerPool.PeriodicallyEnqueue for the format.
+func (c *Client) AddPeriodicSchedule(jobName, spec string, args map[string]interface{}) (*PeriodicSchedule, error) {
+	return c.AddPeriodicScheduleWithOptions(jobName, spec, args, PeriodicJobOptions{})
+}
+
+// AddPeriodicScheduleWithOptions stores a new periodic schedule as per AddPeriodicSchedule, but permits you to specify
+// additional options. A Location is stored as a CRON_TZ= prefix of the spec.
+func (c *Client) AddPeriodicScheduleWithOptions(jobName, spec string, args map[string]interface{}, opts PeriodicJobOptions) (*PeriodicSchedule, error) {
+	if opts.Location != nil && !strings.HasPrefix(spec, "CRON_TZ=") && !strings.HasPrefix(spec, "TZ=") {
+		spec = "CRON_TZ=" + opts.Location.String() + " " + spec
+	}
+
+	s := &PeriodicSchedule{
+		ID:          makeIdentifier(),
+		JobName:     jobName,
+		Spec:        spec,
+		Args:        args,
+		Misfire:     opts.Misfire,
+		MaxMisfires: opts.MaxMisfires,
+	}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	rawJSON, err := s.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("HSET", redisKeyPeriodicSchedules(c.namespace), s.ID, rawJSON); err != nil {
+		logError("client.add_periodic_schedule.hset", err)
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// UpdatePeriodicSchedule replaces the job name, spec, args and misfire options of the schedule with the ID of s. Use
+// DisablePeriodicSchedule and EnablePeriodicSchedule to change whether it's disabled. Jobs already scheduled by the old
+// version of the schedule are removed.
+func (c *Client) UpdatePeriodicSchedule(s *PeriodicSchedule) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+
+	return c.updatePeriodicSchedule(s.ID, func(stored *PeriodicSchedule) {
+		stored.JobName = s.JobName
+		stored.Spec = s.Spec
+		stored.Args = s.Args
+		stored.Misfire = s.Misfire
+		stored.MaxMisfires = s.MaxMisfires
+	})
+}
+
+// DisablePeriodicSchedule stops the schedule with the given ID from enqueueing jobs until it's enabled again.
+func (c *Client) DisablePeriodicSchedule(id string) error {
+	return c.updatePeriodicSchedule(id, func(stored *PeriodicSchedule) {
+		stored.Disabled = true
+	})
+}
+
+// EnablePeriodicSchedule enables a schedule disabled with DisablePeriodicSchedule.
+func (c *Client) EnablePeriodicSchedule(id string) error {
+	return c.updatePeriodicSchedule(id, func(stored *PeriodicSchedule) {
+		stored.Disabled = false
+	})
+}
+
+// DeletePeriodicSchedule deletes the schedule with the given ID along with the jobs it has already scheduled.
+func (c *Client) DeletePeriodicSchedule(id string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	stored, err := getPeriodicSchedule(conn, c.namespace, id)
+	if err == redis.ErrNil {
+		return ErrNotDeleted
+	} else if err != nil {
+		logError("client.delete_periodic_schedule.get", err)
+		return err
+	}
+
+	if err := c.unschedulePeriodicJobs(conn, stored); err != nil {
+		logError("client.delete_periodic_schedule.unschedule", err)
+		return err
+	}
+
+	conn.Send("MULTI")
+	conn.Send("HDEL", redisKeyPeriodicSchedules(c.namespace), id)
+	conn.Send("HDEL", redisKeyPeriodicFireTimes(c.namespace), id)
+	if _, err := conn.Do("EXEC"); err != nil {
+		logError("client.delete_periodic_schedule.exec", err)
+		return err
+	}
+
+	return nil
+}
+
+// periodicScheduleUpdateAttempts is how often updatePeriodicSchedule tries again when the schedule changes under it.
+const periodicScheduleUpdateAttempts = 10
+
+// updatePeriodicSchedule applies update to the stored schedule with the given ID. The schedule is watched while it's
+// updated, so concurrent updates don't overwrite each other; if it changes in the meantime, the update is applied again
+// to the new version.
+func (c *Client) updatePeriodicSchedule(id string, update func(stored *PeriodicSchedule)) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	key := redisKeyPeriodicSchedules(c.namespace)
+	for attempt := 0; attempt < periodicScheduleUpdateAttempts; attempt++ {
+		if _, err := conn.Do("WATCH", key); err != nil {
+			logError("client.update_periodic_schedule.watch", err)
+			return err
+		}
+
+		stored, err := getPeriodicSchedule(conn, c.namespace, id)
+		if err == redis.ErrNil {
+			return ErrScheduleNotFound
+		} else if err != nil {
+			logError("client.update_periodic_schedule.get", err)
+			return err
+		}
+
+		if err := c.unschedulePeriodicJobs(conn, stored); err != nil {
+			logError("client.update_periodic_schedule.unschedule", err)
+			return err
+		}
+
+		update(stored)
+
+		rawJSON, err := stored.serialize()
+		if err != nil {
+			return err
+		}
+
+		conn.Send("MULTI")
+		conn.Send("HSET", key, id, rawJSON)
+		if _, err := redis.Values(conn.Do("EXEC")); err == nil {
+			return nil
+		} else if err != redis.ErrNil {
+			logError("client.update_periodic_schedule.hset", err)
+			return err
+		}
+		// the schedule changed since we read it
+	}
+
+	err := fmt.Errorf("work: periodic schedule %s kept changing while it was being updated", id)
+	logError("client.update_periodic_schedule.attempts", err)
+	return err
+}
+
+// unschedulePeriodicJobs removes the jobs that s has scheduled but which haven't run yet. The periodic enqueuer
+// reschedules them according to the new version of s the next time it runs. Firings of the old version that were
+// missed aren't caught up on.
+func (c *Client) unschedulePeriodicJobs(conn redis.Conn, s *PeriodicSchedule) error {
+	pj := &periodicJob{jobName: s.JobName, spec: s.Spec, args: s.Args, scheduleID: s.ID}
+
+	fireTimes, err := getPeriodicFireTimes(conn, c.namespace, []*periodicJob{pj})
+	if err != nil {
+		return err
+	}
+	ft := fireTimes[0]
+
+	now := nowEpochSeconds()
+	args := []interface{}{redisKeyScheduled(c.namespace)}
+	for _, epoch := range ft.Upcoming {
+		if epoch <= now {
+			continue
+		}
+		rawJSON, err := pj.job(epoch).serialize()
+		if err != nil {
+			return err
+		}
+		args = append(args, rawJSON)
+	}
+
+	if len(args) > 1 {
+		if _, err := conn.Do("ZREM", args...); err != nil {
+			return err
+		}
+	}
+
+	return setPeriodicFireTimes(conn, c.namespace, s.ID, &periodicFireTimes{Last: ft.lastAt(now), Until: now})
+}
+
+// DeleteScheduledJob deletes a job in the scheduled queue.
+func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
+	ok, jobBytes, err := c.deleteZsetJob(redisKeyScheduled(c.namespace), scheduledFor, jobID)
+	if err != nil {
+		return err
+	}
+
+	// If we get a job back, parse it and see if it's a unique job. If it is, we need to delete the unique key.
+	if len(jobBytes) > 0 {
 		job, err := newJob(jobBytes, nil, nil)
 		if err != nil {
 			logError("client.delete_scheduled_job.new_job", err)
@@ -547,42 +1491,143 @@ type jobScore struct {
 	job      *Job
 }
 
-func (c *Client) getZsetPage(key string, page uint) ([]jobScore, int64, error) {
+// queueScanBatchSize is how many jobs are pulled from a queue at a time when looking for one.
+const queueScanBatchSize = 1000
+
+const (
+	// zsetScanBatchSize is how many items are pulled from redis at a time when a filter has to look inside the jobs.
+	zsetScanBatchSize = 1000
+	// zsetFilterScanLimit is how many items a filtered page looks at, at most. Matches further down the zset are neither
+	// returned nor counted; narrow the score range with Since and Until to get at them.
+	zsetFilterScanLimit = 10 * zsetScanBatchSize
+)
+
+// getZsetPage returns the page of the zset's jobs that match filter, and how many match. Without a filter on what's
+// inside the jobs, only the page is pulled from redis, and the score range is counted there.
+func (c *Client) getZsetPage(key string, filter *JobFilter) ([]jobScore, int64, error) {
+	if filter == nil {
+		filter = &JobFilter{}
+	}
+	offset, pageSize := filter.pageBounds()
+	min, max := filter.scoreRange()
+
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	if page == 0 {
-		page = 1
+	if !filter.matchesJobs() {
+		jobsWithScores, err := c.getZsetRange(conn, key, min, max, offset, pageSize)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		total, err := redis.Int64(conn.Do("ZCOUNT", key, min, max))
+		if err != nil {
+			logError("client.get_zset_page.int64", err)
+			return nil, 0, err
+		}
+
+		return jobsWithScores, total, nil
+	}
+
+	var jobsWithScores []jobScore
+	var count int64
+
+	for scanOffset := 0; scanOffset < zsetFilterScanLimit; scanOffset += zsetScanBatchSize {
+		batch, err := c.getZsetRange(conn, key, min, max, uint(scanOffset), zsetScanBatchSize)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, jws := range batch {
+			if !filter.matches(jws.job) {
+				continue
+			}
+			if count >= int64(offset) && count < int64(offset+pageSize) {
+				jobsWithScores = append(jobsWithScores, jws)
+			}
+			count++
+		}
+
+		if len(batch) < zsetScanBatchSize {
+			break
+		}
+	}
+
+	return jobsWithScores, count, nil
+}
+
+// eachFilteredZsetBatch calls fn with the raw members of the zset that matched filter, a batch at a time as they're
+// scanned, so that the zset is never loaded whole. fn must take the members out of their place in the zset: the scan
+// only steps over the members that didn't match. Members that fn adds back, eg dead jobs that can't be requeued, are
+// scored now, above where the scan stops.
+func (c *Client) eachFilteredZsetBatch(key string, filter *JobFilter, fn func(conn redis.Conn, members [][]byte) error) error {
+	if filter == nil {
+		filter = &JobFilter{}
 	}
+	min, max := filter.scoreRange()
+
+	conn := c.pool.Get()
+	defer conn.Close()
 
-	values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, "-inf", "+inf", "WITHSCORES", "LIMIT", (page-1)*20, 20))
+	top, err := redis.Strings(conn.Do("ZREVRANGEBYSCORE", key, max, min, "WITHSCORES", "LIMIT", 0, 1))
+	if err != nil {
+		return err
+	}
+	if len(top) == 0 {
+		return nil
+	}
+	max = top[1]
+
+	var skipped uint
+	for {
+		batch, err := c.getZsetRange(conn, key, min, max, skipped, zsetScanBatchSize)
+		if err != nil {
+			return err
+		}
+
+		var members [][]byte
+		for _, jws := range batch {
+			if filter.matches(jws.job) {
+				members = append(members, jws.JobBytes)
+			} else {
+				skipped++
+			}
+		}
+		if len(members) > 0 {
+			if err := fn(conn, members); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < zsetScanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (c *Client) getZsetRange(conn redis.Conn, key string, min, max interface{}, offset, count uint) ([]jobScore, error) {
+	values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, min, max, "WITHSCORES", "LIMIT", offset, count))
 	if err != nil {
 		logError("client.get_zset_page.values", err)
-		return nil, 0, err
+		return nil, err
 	}
 
 	var jobsWithScores []jobScore
 
 	if err := redis.ScanSlice(values, &jobsWithScores); err != nil {
 		logError("client.get_zset_page.scan_slice", err)
-		return nil, 0, err
+		return nil, err
 	}
 
 	for i, jws := range jobsWithScores {
 		job, err := newJob(jws.JobBytes, nil, nil)
 		if err != nil {
 			logError("client.get_zset_page.new_job", err)
-			return nil, 0, err
+			return nil, err
 		}
 
 		jobsWithScores[i].job = job
 	}
 
-	count, err := redis.Int64(conn.Do("ZCARD", key))
-	if err != nil {
-		logError("client.get_zset_page.int64", err)
-		return nil, 0, err
-	}
-
-	return jobsWithScores, count, nil
+	return jobsWithScores, nil
 }
@@ -2,6 +2,7 @@ package work
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -21,7 +22,9 @@ func TestClientWorkerPoolHeartbeats(t *testing.T) {
 	wp.Job("bob", func(job *Job) error { return nil })
 	wp.Start()
 
-	wp2 := NewWorkerPool(TestContext{}, 11, ns, pool)
+	wp2 := NewWorkerPoolWithOptions(TestContext{}, 11, ns, pool, WorkerPoolOptions{
+		Labels: map[string]string{"version": "1.4.2", "region": "eu-west-1"},
+	})
 	wp2.Job("foo", func(job *Job) error { return nil })
 	wp2.Job("bar", func(job *Job) error { return nil })
 	wp2.Start()
@@ -48,11 +51,13 @@ func TestClientWorkerPoolHeartbeats(t *testing.T) {
 		assert.EqualValues(t, uint(10), hbwp.Concurrency)
 		assert.Equal(t, []string{"bob", "wat"}, hbwp.JobNames)
 		assert.Equal(t, wp.workerIDs(), hbwp.WorkerIDs)
+		assert.Nil(t, hbwp.Labels)
 
 		assert.Equal(t, wp2.workerPoolID, hbwp2.WorkerPoolID)
 		assert.EqualValues(t, uint(11), hbwp2.Concurrency)
 		assert.Equal(t, []string{"bar", "foo"}, hbwp2.JobNames)
 		assert.Equal(t, wp2.workerIDs(), hbwp2.WorkerIDs)
+		assert.Equal(t, map[string]string{"version": "1.4.2", "region": "eu-west-1"}, hbwp2.Labels)
 	}
 
 	wp.Stop()
@@ -607,6 +612,185 @@ func TestClientRetryAllDeadJobsBig(t *testing.T) {
 	assert.Equal(t, "unknown job when requeueing", job.LastErr)
 }
 
+func TestClientFilteredDeadJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	for i := 0; i < 30; i++ {
+		insertDeadJobWithErr(ns, pool, "wat", Q{"region": "eu", "i": i}, "boom: timeout", int64(1000+i))
+	}
+	for i := 0; i < 5; i++ {
+		insertDeadJobWithErr(ns, pool, "foo", Q{"region": "us"}, "boom: refused", int64(2000+i))
+	}
+
+	client := NewClient(ns, pool)
+
+	jobs, count, err := client.FilteredDeadJobs(&JobFilter{JobName: "wat", PageSize: 25})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 30, count)
+	assert.Equal(t, 25, len(jobs))
+
+	jobs, count, err = client.FilteredDeadJobs(&JobFilter{JobName: "wat", Page: 2, PageSize: 25})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 30, count)
+	if assert.Equal(t, 5, len(jobs)) {
+		assert.EqualValues(t, 1025, jobs[0].DiedAt)
+	}
+
+	jobs, count, err = client.FilteredDeadJobs(&JobFilter{ErrorContains: "refused"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5, count)
+	assert.Equal(t, 5, len(jobs))
+
+	jobs, count, err = client.FilteredDeadJobs(&JobFilter{ArgKey: "i", ArgValue: "7"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	if assert.Equal(t, 1, len(jobs)) {
+		assert.EqualValues(t, 1007, jobs[0].DiedAt)
+	}
+
+	jobs, count, err = client.FilteredDeadJobs(&JobFilter{ArgKey: "region", ArgValue: "us", Since: 2001, Until: 2003})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+	assert.Equal(t, 3, len(jobs))
+
+	jobs, count, err = client.FilteredDeadJobs(&JobFilter{Since: 1010, Until: 1019})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, count)
+	assert.Equal(t, 10, len(jobs))
+}
+
+func TestClientFilteredDeadJobsLimits(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	for i := 0; i < zsetFilterScanLimit+10; i++ {
+		name := "wat"
+		if i >= zsetFilterScanLimit {
+			name = "foo"
+		}
+		rawJSON, _ := (&Job{Name: name, ID: makeIdentifier(), EnqueuedAt: int64(i), FailedAt: int64(i)}).serialize()
+		conn.Send("ZADD", redisKeyDead(ns), i, rawJSON)
+	}
+	assert.NoError(t, conn.Flush())
+	for i := 0; i < zsetFilterScanLimit+10; i++ {
+		_, err := conn.Receive()
+		assert.NoError(t, err)
+	}
+	conn.Close()
+
+	client := NewClient(ns, pool)
+
+	// Page sizes are capped.
+	jobs, count, err := client.FilteredDeadJobs(&JobFilter{PageSize: 1000})
+	assert.NoError(t, err)
+	assert.EqualValues(t, zsetFilterScanLimit+10, count)
+	assert.Equal(t, maxJobFilterPageSize, len(jobs))
+
+	// Filters that decode jobs stop looking after zsetFilterScanLimit jobs, unless the score range is narrowed down.
+	jobs, count, err = client.FilteredDeadJobs(&JobFilter{JobName: "foo"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+	assert.Equal(t, 0, len(jobs))
+
+	jobs, count, err = client.FilteredDeadJobs(&JobFilter{JobName: "foo", Since: zsetFilterScanLimit})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, count)
+	assert.Equal(t, 10, len(jobs))
+}
+
+func TestClientRetryDeadJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	for i := 0; i < 1500; i++ {
+		insertDeadJobWithErr(ns, pool, "wat", nil, "boom: timeout", 1000)
+	}
+	insertDeadJobWithErr(ns, pool, "wat", nil, "boom: refused", 1000)
+	insertDeadJobWithErr(ns, pool, "foo", nil, "boom: timeout", 1000)
+
+	client := NewClient(ns, pool)
+	n, err := client.RetryDeadJobs(&JobFilter{JobName: "wat", ErrorContains: "timeout"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1500, n)
+
+	assert.EqualValues(t, 1500, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyDead(ns)))
+
+	job := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.EqualValues(t, 0, job.Fails)
+	assert.Equal(t, "", job.LastErr)
+}
+
+func TestClientRetryDeadJobsInBatches(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	// Matches and misses are interleaved across scan batches, and jobs that can't be requeued go back on dead.
+	for i := 0; i < 2500; i++ {
+		args := Q{"bulk": true}
+		if i%2 == 1 {
+			args = nil
+		}
+		insertDeadJobWithErr(ns, pool, "wat", args, "boom", int64(1000+i))
+	}
+	for i := 0; i < 3; i++ {
+		insertDeadJobWithErr(ns, pool, "gone", Q{"bulk": true}, "boom", int64(1000+i))
+	}
+	conn := pool.Get()
+	_, err := conn.Do("SREM", redisKeyKnownJobs(ns), "gone")
+	conn.Close()
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	n, err := client.RetryDeadJobs(&JobFilter{ArgKey: "bulk"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1250, n)
+	assert.EqualValues(t, 1250, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	jobs, count, err := client.FilteredDeadJobs(&JobFilter{ArgKey: "bulk"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+	if assert.Equal(t, 3, len(jobs)) {
+		assert.Equal(t, "gone", jobs[0].Name)
+		assert.Equal(t, "unknown job when requeueing", jobs[0].LastErr)
+	}
+
+	n, err = client.DeleteDeadJobs(&JobFilter{JobName: "wat"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1250, n)
+	assert.EqualValues(t, 3, zsetSize(pool, redisKeyDead(ns)))
+}
+
+func TestClientDeleteDeadJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	insertDeadJobWithErr(ns, pool, "wat", nil, "boom", 1000)
+	insertDeadJobWithErr(ns, pool, "wat", nil, "boom", 1001)
+	insertDeadJobWithErr(ns, pool, "wat", nil, "boom", 1002)
+	insertDeadJobWithErr(ns, pool, "foo", nil, "boom", 1001)
+
+	client := NewClient(ns, pool)
+	n, err := client.DeleteDeadJobs(&JobFilter{JobName: "wat", Since: 1001})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+
+	jobs, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	if assert.Equal(t, 2, len(jobs)) {
+		assert.EqualValues(t, 1000, jobs[0].DiedAt)
+		assert.Equal(t, "foo", jobs[1].Name)
+	}
+}
+
 func TestClientDeleteScheduledJob(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
@@ -683,6 +867,384 @@ func TestClientDeleteRetryJob(t *testing.T) {
 	}
 }
 
+func TestClientQueuedJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	var ids []string
+	for i := 0; i < 25; i++ {
+		j, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+		ids = append(ids, j.ID)
+	}
+
+	client := NewClient(ns, pool)
+	jobs, count, err := client.QueuedJobs("wat", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 25, count)
+	if assert.Equal(t, 20, len(jobs)) {
+		assert.Equal(t, ids[0], jobs[0].ID)
+		assert.Equal(t, ids[19], jobs[19].ID)
+		assert.EqualValues(t, 0, jobs[0].ArgInt64("i"))
+	}
+
+	jobs, count, err = client.QueuedJobs("wat", 2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 25, count)
+	if assert.Equal(t, 5, len(jobs)) {
+		assert.Equal(t, ids[20], jobs[0].ID)
+		assert.Equal(t, ids[24], jobs[4].ID)
+	}
+
+	jobs, count, err = client.QueuedJobs("nope", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+	assert.Equal(t, 0, len(jobs))
+}
+
+func TestClientQueuedJobsInGroups(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	var ids []string
+	for i := 0; i < 15; i++ {
+		j, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+		ids = append(ids, j.ID)
+	}
+	for i := 0; i < 10; i++ {
+		j, err := enqueuer.EnqueueForGroup("wat", "tenant1", Q{"i": i})
+		assert.NoError(t, err)
+		ids = append(ids, j.ID)
+	}
+	unique, err := enqueuer.EnqueueUniqueWithOptions("wat", Q{"a": 1}, UniqueOptions{})
+	assert.NoError(t, err)
+	grouped, err := enqueuer.EnqueueForGroup("wat", "tenant2", nil)
+	assert.NoError(t, err)
+
+	// Jobs without a group come first, and the page spans the queues.
+	client := NewClient(ns, pool)
+	jobs, count, err := client.QueuedJobs("wat", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 27, count)
+	if assert.Equal(t, 20, len(jobs)) {
+		assert.Equal(t, ids[0], jobs[0].ID)
+		assert.Equal(t, ids[14], jobs[14].ID)
+		assert.Equal(t, unique.ID, jobs[15].ID)
+		assert.Equal(t, ids[15], jobs[16].ID)
+		assert.Equal(t, "tenant1", jobs[16].Group)
+	}
+
+	jobs, count, err = client.QueuedJobs("wat", 2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 27, count)
+	if assert.Equal(t, 7, len(jobs)) {
+		assert.Equal(t, ids[19], jobs[0].ID)
+		assert.Equal(t, ids[24], jobs[5].ID)
+		assert.Equal(t, grouped.ID, jobs[6].ID)
+	}
+
+	// Deleting the only job of a group removes the group.
+	err = client.DeleteQueuedJob("wat", grouped.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsGroup(ns, "wat", "tenant2")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsGroups(ns, "wat")))
+
+	count, err = client.PurgeQueue("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 26, count)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsGroup(ns, "wat", "tenant1")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsGroups(ns, "wat")))
+}
+
+func TestClientQueuedJobsInLane(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+	conn := pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HSET", redisKeyJobsLanes(ns, "wat"), "high", 10)
+	assert.NoError(t, err)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		j, err := enqueuer.EnqueueToLane("wat", "high", Q{"i": i})
+		assert.NoError(t, err)
+		ids = append(ids, j.ID)
+	}
+
+	client := NewClient(ns, pool)
+	high := &Queue{JobName: "wat", Lane: "high"}
+	jobs, count, err := client.QueuedJobsIn(high, 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+	if assert.Equal(t, 3, len(jobs)) {
+		assert.Equal(t, ids[0], jobs[0].ID)
+		assert.Equal(t, "high", jobs[0].Lane)
+	}
+
+	err = client.DeleteQueuedJob("wat", ids[0])
+	assert.Equal(t, ErrNotDeleted, err)
+	err = client.DeleteQueuedJobIn(high, ids[0])
+	assert.NoError(t, err)
+
+	count, err = client.PurgeQueueIn(high)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsLane(ns, "wat", "high")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestClientQueuedJobsInNamedQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		j, err := enqueuer.EnqueueTo("enterprise", "wat", Q{"i": i})
+		assert.NoError(t, err)
+		ids = append(ids, j.ID)
+	}
+
+	client := NewClient(ns, pool)
+	enterprise := &Queue{JobName: "wat", Queue: "enterprise"}
+	jobs, count, err := client.QueuedJobsIn(enterprise, 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+	if assert.Equal(t, 3, len(jobs)) {
+		assert.Equal(t, ids[0], jobs[0].ID)
+		assert.Equal(t, "enterprise", jobs[0].Queue)
+	}
+
+	err = client.DeleteQueuedJob("wat", ids[0])
+	assert.Equal(t, ErrNotDeleted, err)
+	err = client.DeleteQueuedJobIn(enterprise, ids[0])
+	assert.NoError(t, err)
+
+	count, err = client.PurgeQueueIn(enterprise)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsQueue(ns, "wat", "enterprise")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestClientDeleteQueuedJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	err := client.DeleteQueuedJob("wat", "bob")
+	assert.Equal(t, ErrNotDeleted, err)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	j1, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	j2, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	err = client.DeleteQueuedJob("wat", j1.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	job := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, j2.ID, job.ID)
+
+	// Jobs past the first chunk of a long queue are found too.
+	j3, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	for i := 0; i < queueScanBatchSize+10; i++ {
+		_, err = enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+	err = client.DeleteQueuedJob("wat", j3.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, queueScanBatchSize+10, listSize(pool, redisKeyJobs(ns, "wat")))
+	err = client.DeleteQueuedJob("wat", j3.ID)
+	assert.Equal(t, ErrNotDeleted, err)
+}
+
+func TestClientDeleteQueuedUniqueJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	// Enqueue a unique job. Delete it. Ensure we can enqueue it again.
+	enqueuer := NewEnqueuer(ns, pool)
+	j, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, j)
+
+	client := NewClient(ns, pool)
+	err = client.DeleteQueuedJob("wat", j.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	j, err = enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, j)
+}
+
+func TestClientPurgeQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 2500; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+	j, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, j)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	count, err := client.PurgeQueue("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2501, count)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+
+	j, err = enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, j)
+}
+
+func TestClientRetryNow(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.Nil(t, err)
+
+	wp := NewWorkerPool(TestContext{}, 10, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := NewClient(ns, pool)
+	err = client.RetryNow(12345, job.ID)
+	assert.Equal(t, ErrNotRetried, err)
+
+	jobs, count, err := client.RetryJobs(1)
+	assert.NoError(t, err)
+	if assert.EqualValues(t, 1, count) {
+		err = client.RetryNow(jobs[0].RetryAt, job.ID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+
+		queued := getQueuedJob(ns, pool, "wat")
+		if assert.NotNil(t, queued) {
+			assert.Equal(t, job.ID, queued.ID)
+			assert.EqualValues(t, 1, queued.Fails)
+			assert.Equal(t, "ohno", queued.LastErr)
+			assert.EqualValues(t, 1, queued.ArgInt64("a"))
+		}
+	}
+}
+
+func TestClientRunScheduledNow(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	j, err := enqueuer.EnqueueIn("wat", 1000, nil)
+	assert.NoError(t, err)
+	unknown, err := enqueuer.EnqueueIn("wat", 1000, nil)
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	err = client.RunScheduledNow(j.RunAt, j.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+
+	queued := getQueuedJob(ns, pool, "wat")
+	if assert.NotNil(t, queued) {
+		assert.Equal(t, j.ID, queued.ID)
+	}
+
+	// Jobs we don't know how to enqueue stay put
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("SREM", redisKeyKnownJobs(ns), "wat")
+	assert.NoError(t, err)
+	err = client.RunScheduledNow(unknown.RunAt, unknown.ID)
+	assert.Equal(t, ErrNotRetried, err)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+}
+
+func TestClientRetryAllRetryJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	for i := 0; i < 1500; i++ {
+		job := &Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: 12345, Fails: 1, LastErr: "ohno"}
+		rawJSON, _ := job.serialize()
+		_, err := conn.Do("ZADD", redisKeyRetry(ns), 99999999999, rawJSON)
+		assert.NoError(t, err)
+	}
+	job := &Job{Name: "dontexist", ID: makeIdentifier(), EnqueuedAt: 12345, Fails: 1}
+	rawJSON, _ := job.serialize()
+	_, err := conn.Do("ZADD", redisKeyRetry(ns), 99999999999, rawJSON)
+	assert.NoError(t, err)
+	_, err = conn.Do("SADD", redisKeyKnownJobs(ns), "wat")
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	err = client.RetryAllRetryJobs()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1500, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	_, dead := jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, "dontexist", dead.Name)
+	assert.Equal(t, "unknown job when requeueing", dead.LastErr)
+}
+
+func TestClientRunAllScheduledJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueIn("wat", 1000, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("foo", 2000, nil)
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	err = client.RunAllScheduledJobs()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+}
+
 func insertDeadJob(ns string, pool *redis.Pool, name string, encAt, failAt int64) *Job {
 	job := &Job{
 		Name:       name,
@@ -724,3 +1286,117 @@ func getQueuedJob(ns string, pool *redis.Pool, name string) *Job {
 	}
 	return job
 }
+
+func insertDeadJobWithErr(ns string, pool *redis.Pool, name string, args map[string]interface{}, lastErr string, failAt int64) *Job {
+	job := &Job{
+		Name:       name,
+		ID:         makeIdentifier(),
+		EnqueuedAt: failAt,
+		Args:       args,
+		Fails:      4,
+		LastErr:    lastErr,
+		FailedAt:   failAt,
+	}
+
+	rawJSON, _ := job.serialize()
+
+	conn := pool.Get()
+	defer conn.Close()
+	if _, err := conn.Do("ZADD", redisKeyDead(ns), failAt, rawJSON); err != nil {
+		panic(err.Error())
+	}
+
+	if _, err := conn.Do("SADD", redisKeyKnownJobs(ns), name); err != nil {
+		panic(err)
+	}
+
+	return job
+}
+
+func TestClientPeriodicSchedules(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	client := NewClient(ns, pool)
+
+	_, err := client.AddPeriodicSchedule("report", "not a spec", nil)
+	assert.Error(t, err)
+	_, err = client.AddPeriodicSchedule("", "0 * * * * *", nil)
+	assert.Error(t, err)
+
+	s, err := client.AddPeriodicSchedule("report", "CRON_TZ=Europe/Berlin 0 0 9 * * *", Q{"region": "eu"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, s.ID)
+
+	schedules, err := client.PeriodicSchedules()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(schedules)) {
+		assert.Equal(t, s.ID, schedules[0].ID)
+		assert.Equal(t, "report", schedules[0].JobName)
+		assert.Equal(t, "CRON_TZ=Europe/Berlin 0 0 9 * * *", schedules[0].Spec)
+		assert.Equal(t, "eu", schedules[0].Args["region"])
+		assert.False(t, schedules[0].Disabled)
+		assert.True(t, schedules[0].NextFireAt > 0)
+	}
+
+	assert.NoError(t, client.DisablePeriodicSchedule(s.ID))
+	schedules, err = client.PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.True(t, schedules[0].Disabled)
+	assert.EqualValues(t, 0, schedules[0].NextFireAt)
+
+	assert.NoError(t, client.EnablePeriodicSchedule(s.ID))
+	schedules, err = client.PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.False(t, schedules[0].Disabled)
+
+	assert.NoError(t, client.UpdatePeriodicSchedule(&PeriodicSchedule{ID: s.ID, JobName: "summary", Spec: "@every 1h"}))
+	schedules, err = client.PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.Equal(t, "summary", schedules[0].JobName)
+	assert.Equal(t, "@every 1h", schedules[0].Spec)
+	assert.Nil(t, schedules[0].Args)
+
+	assert.Error(t, client.UpdatePeriodicSchedule(&PeriodicSchedule{ID: s.ID, JobName: "summary", Spec: "nope"}))
+	assert.Equal(t, ErrScheduleNotFound, client.DisablePeriodicSchedule("nope"))
+	assert.Equal(t, ErrScheduleNotFound, client.UpdatePeriodicSchedule(&PeriodicSchedule{ID: "nope", JobName: "summary", Spec: "@every 1h"}))
+
+	assert.NoError(t, client.DeletePeriodicSchedule(s.ID))
+	assert.Equal(t, ErrNotDeleted, client.DeletePeriodicSchedule(s.ID))
+	schedules, err = client.PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(schedules))
+}
+
+func TestClientConcurrentPeriodicScheduleUpdates(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	client := NewClient(ns, pool)
+
+	// Disabling a schedule while it's being edited elsewhere sticks, and so does the edit.
+	for i := 0; i < 20; i++ {
+		s, err := client.AddPeriodicSchedule("report", "@every 1h", nil)
+		assert.NoError(t, err)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.DisablePeriodicSchedule(s.ID))
+		}()
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.UpdatePeriodicSchedule(&PeriodicSchedule{ID: s.ID, JobName: "summary", Spec: "@every 2h"}))
+		}()
+		wg.Wait()
+
+		conn := pool.Get()
+		stored, err := getPeriodicSchedule(conn, ns, s.ID)
+		conn.Close()
+		assert.NoError(t, err)
+		assert.True(t, stored.Disabled)
+		assert.Equal(t, "summary", stored.JobName)
+		assert.Equal(t, "@every 2h", stored.Spec)
+	}
+}
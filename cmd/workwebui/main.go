@@ -6,9 +6,10 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/gocraft/work/webui"
+	"github.com/kit-x/work/webui"
 	"github.com/gomodule/redigo/redis"
 )
 
@@ -17,6 +18,8 @@ var (
 	redisDatabase  = flag.String("database", "0", "redis database")
 	redisNamespace = flag.String("ns", "work", "redis namespace")
 	webHostPort    = flag.String("listen", ":5040", "hostport to listen for HTTP JSON API")
+	basicAuth      = flag.String("basic-auth", "", "comma separated user:password:role entries for HTTP basic auth, or $WORKWEBUI_BASIC_AUTH")
+	bearerTokens   = flag.String("bearer-tokens", "", "comma separated user:token:role entries for API clients, or $WORKWEBUI_BEARER_TOKENS")
 )
 
 func main() {
@@ -34,9 +37,41 @@ func main() {
 		return
 	}
 
+	// Secrets are better kept out of the command line, where other users can see them.
+	if *basicAuth == "" {
+		*basicAuth = os.Getenv("WORKWEBUI_BASIC_AUTH")
+	}
+	if *bearerTokens == "" {
+		*bearerTokens = os.Getenv("WORKWEBUI_BEARER_TOKENS")
+	}
+
+	var options webui.ServerOptions
+	if *basicAuth != "" {
+		options.BasicAuth = make(map[string]webui.BasicAuthUser)
+		err := parseAuthEntries(*basicAuth, func(user, password string, role webui.Role) {
+			options.BasicAuth[user] = webui.BasicAuthUser{Password: password, Role: role}
+		})
+		if err != nil {
+			fmt.Printf("Error: invalid basic auth: %v", err)
+			return
+		}
+	}
+	if *bearerTokens != "" {
+		options.BearerTokens = make(map[string]webui.BearerToken)
+		err := parseAuthEntries(*bearerTokens, func(user, token string, role webui.Role) {
+			options.BearerTokens[token] = webui.BearerToken{User: user, Role: role}
+		})
+		if err != nil {
+			fmt.Printf("Error: invalid bearer tokens: %v", err)
+			return
+		}
+	}
+	fmt.Println("basic auth users = ", len(options.BasicAuth))
+	fmt.Println("bearer tokens = ", len(options.BearerTokens))
+
 	pool := newPool(*redisHostPort, database)
 
-	server := webui.NewServer(*redisNamespace, pool, *webHostPort)
+	server := webui.NewServerWithOptions(*redisNamespace, pool, *webHostPort, options)
 	server.Start()
 
 	c := make(chan os.Signal, 1)
@@ -49,6 +84,24 @@ func main() {
 	fmt.Println("\nQuitting...")
 }
 
+// parseAuthEntries calls add for each of the comma separated name:secret:role entries. The secret can contain colons.
+func parseAuthEntries(entries string, add func(name, secret string, role webui.Role)) error {
+	for i, entry := range strings.Split(entries, ",") {
+		first, last := strings.Index(entry, ":"), strings.LastIndex(entry, ":")
+		if first <= 0 || first == last {
+			return fmt.Errorf("entry %d isn't name:secret:role", i+1) // without the secret
+		}
+		role := webui.Role(entry[last+1:])
+		switch role {
+		case webui.RoleViewer, webui.RoleOperator, webui.RoleAdmin:
+		default:
+			return fmt.Errorf("%q has an unknown role %q", entry[:first], role)
+		}
+		add(entry[:first], entry[first+1:last], role)
+	}
+	return nil
+}
+
 func newPool(addr string, database int) *redis.Pool {
 	return &redis.Pool{
 		MaxActive:   3,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -13,7 +14,8 @@ const (
 	deadTime          = 10 * time.Second // 2 x heartbeat
 	reapPeriod        = 10 * time.Minute
 	reapJitterSecs    = 30
-	requeueKeysPerJob = 4
+	requeueKeysPerJob = 6
+	reapKeysPerJob    = 4
 )
 
 type deadPoolReaper struct {
@@ -21,6 +23,7 @@ type deadPoolReaper struct {
 	pool        *redis.Pool
 	deadTime    time.Duration
 	reapPeriod  time.Duration
+	mtx         sync.Mutex // guards curJobTypes
 	curJobTypes []string
 
 	stopChan         chan struct{}
@@ -39,6 +42,12 @@ func newDeadPoolReaper(namespace string, pool *redis.Pool, curJobTypes []string)
 	}
 }
 
+func (r *deadPoolReaper) setJobTypes(curJobTypes []string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.curJobTypes = curJobTypes
+}
+
 func (r *deadPoolReaper) start() {
 	go r.loop()
 }
@@ -93,7 +102,9 @@ func (r *deadPoolReaper) reap() error {
 			}
 		} else {
 			// try to clean up locks for the current set of jobs if heartbeat was not found
+			r.mtx.Lock()
 			lockJobTypes = r.curJobTypes
+			r.mtx.Unlock()
 		}
 		// Remove dead pool from worker pools set
 		if _, err = conn.Do("SREM", workerPoolsKey, deadPoolID); err != nil {
@@ -109,12 +120,13 @@ func (r *deadPoolReaper) reap() error {
 }
 
 func (r *deadPoolReaper) cleanStaleLockInfo(poolID string, jobTypes []string) error {
-	numKeys := len(jobTypes) * 2
+	numKeys := len(jobTypes) * reapKeysPerJob
 	redisReapLocksScript := redis.NewScript(numKeys, redisLuaReapStaleLocks)
 	var scriptArgs = make([]interface{}, 0, numKeys+1) // +1 for argv[1]
 
 	for _, jobType := range jobTypes {
 		scriptArgs = append(scriptArgs, redisKeyJobsLock(r.namespace, jobType), redisKeyJobsLockInfo(r.namespace, jobType))
+		scriptArgs = append(scriptArgs, redisKeyJobsGroupLock(r.namespace, jobType), redisKeyJobsGroupLockInfo(r.namespace, jobType))
 	}
 	scriptArgs = append(scriptArgs, poolID) // ARGV[1]
 
@@ -135,6 +147,7 @@ func (r *deadPoolReaper) requeueInProgressJobs(poolID string, jobTypes []string)
 	for _, jobType := range jobTypes {
 		// pops from in progress, push into job queue and decrement the queue lock
 		scriptArgs = append(scriptArgs, redisKeyJobsInProgress(r.namespace, poolID, jobType), redisKeyJobs(r.namespace, jobType), redisKeyJobsLock(r.namespace, jobType), redisKeyJobsLockInfo(r.namespace, jobType)) // KEYS[1-4 * N]
+		scriptArgs = append(scriptArgs, redisKeyJobsGroupLock(r.namespace, jobType), redisKeyJobsGroupLockInfo(r.namespace, jobType))                                                                                 // KEYS[5-6 * N]
 	}
 	scriptArgs = append(scriptArgs, poolID) // ARGV[1]
 
@@ -271,7 +271,7 @@ func TestDeadPoolReaperWithWorkerPools(t *testing.T) {
 	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, stalePoolID, job1), `{"sleep": 10}`)
 	assert.NoError(t, err)
 	jobTypes := map[string]*jobType{"job1": nil}
-	staleHeart := newWorkerPoolHeartbeater(ns, pool, stalePoolID, jobTypes, 1, []string{"id1"})
+	staleHeart := newWorkerPoolHeartbeater(ns, pool, stalePoolID, jobTypes, 1, []string{"id1"}, nil)
 	staleHeart.start()
 
 	// should have 1 stale job and empty job queue
@@ -280,9 +280,9 @@ func TestDeadPoolReaperWithWorkerPools(t *testing.T) {
 
 	// setup a worker pool and start the reaper, which should restart the stale job above
 	wp := setupTestWorkerPool(pool, ns, job1, 1, JobOptions{Priority: 1})
-	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, []string{"job1"})
-	wp.deadPoolReaper.deadTime = expectedDeadTime
-	wp.deadPoolReaper.start()
+	reaper := newDeadPoolReaper(wp.namespace, wp.pool, []string{"job1"})
+	reaper.deadTime = expectedDeadTime
+	reaper.start()
 
 	// sleep long enough for staleJob to be considered dead
 	time.Sleep(expectedDeadTime * 2)
@@ -291,7 +291,7 @@ func TestDeadPoolReaperWithWorkerPools(t *testing.T) {
 	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
 	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
 	staleHeart.stop()
-	wp.deadPoolReaper.stop()
+	reaper.stop()
 }
 
 func TestDeadPoolReaperCleanStaleLocks(t *testing.T) {
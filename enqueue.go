@@ -1,22 +1,57 @@
 package work
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
 
+// UniqueMode decides for how long a unique job keeps duplicates out, see EnqueueUnique.
+type UniqueMode string
+
+const (
+	// UniqueUntilStart keeps duplicates from being enqueued until a worker starts the job. This is the default.
+	UniqueUntilStart UniqueMode = "until_start"
+	// UniqueUntilComplete keeps duplicates from being enqueued until the job is done running, whether it succeeded or not.
+	UniqueUntilComplete UniqueMode = "until_complete"
+	// UniqueWhileExecuting lets duplicates be enqueued, but only runs one of them at a time. Duplicates that would
+	// run at the same time are rescheduled, see JobOptions.NoOverlapDelay.
+	UniqueWhileExecuting UniqueMode = "while_executing"
+)
+
+const defaultUniqueTTL = 24 * time.Hour
+
+func (m UniqueMode) validate() error {
+	switch m {
+	case "", UniqueUntilStart, UniqueUntilComplete, UniqueWhileExecuting:
+		return nil
+	}
+	return fmt.Errorf("work: unknown unique mode %q", string(m))
+}
+
+// UniqueOptions can be passed to EnqueueUniqueWithOptions and EnqueueUniqueInWithOptions. Options that aren't set fall
+// back to the UniqueMode and UniqueTTL in the JobOptions of the job's worker pool.
+type UniqueOptions struct {
+	Mode   UniqueMode
+	TTL    time.Duration          // How long the job is unique for at most. Defaults to 24 hours.
+	KeyMap map[string]interface{} // If set, the job is unique by these instead of its args, see EnqueueUniqueByKey.
+}
+
 // Enqueuer can enqueue jobs.
 type Enqueuer struct {
 	Namespace string // eg, "myapp-work"
 	Pool      *redis.Pool
 
-	queuePrefix           string // eg, "myapp-work:jobs:"
-	knownJobs             map[string]int64
-	enqueueUniqueScript   *redis.Script
-	enqueueUniqueInScript *redis.Script
-	mtx                   sync.RWMutex
+	queuePrefix            string // eg, "myapp-work:jobs:"
+	knownJobs              map[string]int64
+	enqueueUniqueScript    *redis.Script
+	enqueueUniqueInScript  *redis.Script
+	enqueueDebouncedScript *redis.Script
+	enqueueThrottledScript *redis.Script
+	pushJobScript          *redis.Script
+	mtx                    sync.RWMutex
 }
 
 // NewEnqueuer creates a new enqueuer with the specified Redis namespace and Redis pool.
@@ -26,12 +61,15 @@ func NewEnqueuer(namespace string, pool *redis.Pool) *Enqueuer {
 	}
 
 	return &Enqueuer{
-		Namespace:             namespace,
-		Pool:                  pool,
-		queuePrefix:           redisKeyJobsPrefix(namespace),
-		knownJobs:             make(map[string]int64),
-		enqueueUniqueScript:   redis.NewScript(2, redisLuaEnqueueUnique),
-		enqueueUniqueInScript: redis.NewScript(2, redisLuaEnqueueUniqueIn),
+		Namespace:              namespace,
+		Pool:                   pool,
+		queuePrefix:            redisKeyJobsPrefix(namespace),
+		knownJobs:              make(map[string]int64),
+		enqueueUniqueScript:    redis.NewScript(3, redisLuaEnqueueUnique),
+		enqueueUniqueInScript:  redis.NewScript(3, redisLuaEnqueueUniqueIn),
+		enqueueDebouncedScript: redis.NewScript(2, redisLuaEnqueueDebounced),
+		enqueueThrottledScript: redis.NewScript(2, redisLuaEnqueueThrottled),
+		pushJobScript:          redis.NewScript(1, redisLuaEnqueuePushJob),
 	}
 }
 
@@ -98,11 +136,213 @@ func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[stri
 	return scheduledJob, nil
 }
 
+// EnqueueForGroup enqueues a job on the queue of a group, eg a tenant, within the job's queue. Workers take turns
+// between groups, so a group with a lot of jobs doesn't hold up the others, and JobOptions.MaxGroupConcurrency limits
+// how many jobs of a group run at once. Jobs without a group take turns with the groups, as if they were one more group.
+func (e *Enqueuer) EnqueueForGroup(jobName, group string, args map[string]interface{}) (*Job, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		Group:      group,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	if _, err := e.pushJobScript.Do(conn, e.queuePrefix+jobName, rawJSON, group, "", ""); err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
+
+// EnqueueInForGroup enqueues a job in the scheduled job queue for execution in secondsFromNow seconds. When it's due,
+// it's moved to the queue of its group, see EnqueueForGroup.
+func (e *Enqueuer) EnqueueInForGroup(jobName, group string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		Group:      group,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	scheduledJob := &ScheduledJob{
+		RunAt: nowEpochSeconds() + secondsFromNow,
+		Job:   job,
+	}
+
+	_, err = conn.Do("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return scheduledJob, err
+	}
+
+	return scheduledJob, nil
+}
+
+// EnqueueToLane enqueues a job on one of the lanes of its job type, see JobOptions.Lanes. Each lane is a queue with its
+// own priority, so some jobs of a type can jump ahead of the others. If the job type has no such lane, the job goes on
+// the normal queue.
+func (e *Enqueuer) EnqueueToLane(jobName, lane string, args map[string]interface{}) (*Job, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		Lane:       lane,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	if _, err := e.pushJobScript.Do(conn, e.queuePrefix+jobName, rawJSON, "", lane, ""); err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
+
+// EnqueueInToLane enqueues a job in the scheduled job queue for execution in secondsFromNow seconds. When it's due,
+// it's moved to its lane, see EnqueueToLane.
+func (e *Enqueuer) EnqueueInToLane(jobName, lane string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		Lane:       lane,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	scheduledJob := &ScheduledJob{
+		RunAt: nowEpochSeconds() + secondsFromNow,
+		Job:   job,
+	}
+
+	_, err = conn.Do("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return scheduledJob, err
+	}
+
+	return scheduledJob, nil
+}
+
+// EnqueueTo enqueues a job on a named queue of its job type, which only the worker pools subscribed to the queue fetch
+// from, see WorkerPoolOptions.Queues. Jobs stay on the queue until such a pool runs them, and are retried on it. An
+// empty queue name means the job type's normal queue.
+func (e *Enqueuer) EnqueueTo(queue, jobName string, args map[string]interface{}) (*Job, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		Queue:      queue,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	if _, err := e.pushJobScript.Do(conn, e.queuePrefix+jobName, rawJSON, "", "", queue); err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
+
+// EnqueueInTo enqueues a job in the scheduled job queue for execution in secondsFromNow seconds. When it's due, it's
+// moved to its named queue, see EnqueueTo.
+func (e *Enqueuer) EnqueueInTo(queue, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		Queue:      queue,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	scheduledJob := &ScheduledJob{
+		RunAt: nowEpochSeconds() + secondsFromNow,
+		Job:   job,
+	}
+
+	_, err = conn.Do("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return scheduledJob, err
+	}
+
+	return scheduledJob, nil
+}
+
 // EnqueueUnique enqueues a job unless a job is already enqueued with the same name and arguments.
 // The already-enqueued job can be in the normal work queue or in the scheduled job queue.
 // Once a worker begins processing a job, another job with the same name and arguments can be enqueued again.
 // Any failed jobs in the retry queue or dead queue don't count against the uniqueness -- so if a job fails and is retried, two unique jobs with the same name and arguments can be enqueued at once.
 // In order to add robustness to the system, jobs are only unique for 24 hours after they're enqueued. This is mostly relevant for scheduled jobs.
+// The uniqueness period and TTL can be changed with JobOptions.UniqueMode and JobOptions.UniqueTTL, or per job with EnqueueUniqueWithOptions.
 // EnqueueUnique returns the job if it was enqueued and nil if it wasn't
 func (e *Enqueuer) EnqueueUnique(jobName string, args map[string]interface{}) (*Job, error) {
 	return e.EnqueueUniqueByKey(jobName, args, nil)
@@ -113,6 +353,41 @@ func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args ma
 	return e.EnqueueUniqueInByKey(jobName, secondsFromNow, args, nil)
 }
 
+// EnqueueUniqueWithOptions enqueues a unique job as per EnqueueUnique, but permits you to specify the unique mode and TTL.
+// It returns the job if it was enqueued and nil if it wasn't
+func (e *Enqueuer) EnqueueUniqueWithOptions(jobName string, args map[string]interface{}, opts UniqueOptions) (*Job, error) {
+	enqueue, job, err := e.uniqueJobHelper(jobName, args, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := enqueue(nil)
+	if res == "ok" && err == nil {
+		return job, nil
+	}
+	return nil, err
+}
+
+// EnqueueUniqueInWithOptions enqueues a unique job in the scheduled job queue for execution in secondsFromNow seconds
+// as per EnqueueUniqueIn, but permits you to specify the unique mode and TTL.
+func (e *Enqueuer) EnqueueUniqueInWithOptions(jobName string, secondsFromNow int64, args map[string]interface{}, opts UniqueOptions) (*ScheduledJob, error) {
+	enqueue, job, err := e.uniqueJobHelper(jobName, args, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledJob := &ScheduledJob{
+		RunAt: nowEpochSeconds() + secondsFromNow,
+		Job:   job,
+	}
+
+	res, err := enqueue(&scheduledJob.RunAt)
+	if res == "ok" && err == nil {
+		return scheduledJob, nil
+	}
+	return nil, err
+}
+
 // EnqueueUniqueByKey enqueues a job unless a job is already enqueued with the same name and key, updating arguments.
 // The already-enqueued job can be in the normal work queue or in the scheduled job queue.
 // Once a worker begins processing a job, another job with the same name and key can be enqueued again.
@@ -120,7 +395,7 @@ func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args ma
 // In order to add robustness to the system, jobs are only unique for 24 hours after they're enqueued. This is mostly relevant for scheduled jobs.
 // EnqueueUniqueByKey returns the job if it was enqueued and nil if it wasn't
 func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
-	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
+	enqueue, job, err := e.uniqueJobHelper(jobName, args, UniqueOptions{KeyMap: keyMap})
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +411,7 @@ func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{
 // EnqueueUniqueInByKey enqueues a job in the scheduled job queue that is unique on specified key for execution in secondsFromNow seconds. See EnqueueUnique for the semantics of unique jobs.
 // Subsequent calls with same key will update arguments
 func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
-	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
+	enqueue, job, err := e.uniqueJobHelper(jobName, args, UniqueOptions{KeyMap: keyMap})
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +428,89 @@ func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, ar
 	return nil, err
 }
 
+// EnqueueDebounced enqueues a job in the scheduled job queue for execution after delay. If a job with the same name
+// and key is still waiting to run, it's replaced, so the job runs once, with the latest arguments, when no calls were
+// made for delay. The delay is rounded up to whole seconds.
+func (e *Enqueuer) EnqueueDebounced(jobName, key string, delay time.Duration, args map[string]interface{}) (*ScheduledJob, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	delaySeconds := durationSeconds(delay)
+	scheduledJob := &ScheduledJob{
+		RunAt: nowEpochSeconds() + delaySeconds,
+		Job:   job,
+	}
+
+	ttl := delaySeconds + int64(defaultUniqueTTL/time.Second)
+	_, err = e.enqueueDebouncedScript.Do(conn, redisKeyScheduled(e.Namespace), redisKeyDebounce(e.Namespace, jobName, key), rawJSON, scheduledJob.RunAt, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return scheduledJob, err
+	}
+
+	return scheduledJob, nil
+}
+
+// EnqueueThrottled enqueues a job such that at most one job with the same name and key runs per window. If no such job
+// ran in the last window, the job runs right away. Otherwise it runs at the end of the window, and replaces any job
+// that was throttled before it, so calls made during a window collapse into one trailing run with the latest
+// arguments. The returned job's RunAt says when it runs. The window is rounded up to whole seconds.
+func (e *Enqueuer) EnqueueThrottled(jobName, key string, window time.Duration, args map[string]interface{}) (*ScheduledJob, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	runAt, err := redis.Int64(e.enqueueThrottledScript.Do(conn, redisKeyScheduled(e.Namespace), redisKeyThrottle(e.Namespace, jobName, key), rawJSON, nowEpochSeconds(), durationSeconds(window)))
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledJob := &ScheduledJob{
+		RunAt: runAt,
+		Job:   job,
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return scheduledJob, err
+	}
+
+	return scheduledJob, nil
+}
+
+// durationSeconds rounds d up to whole seconds.
+func durationSeconds(d time.Duration) int64 {
+	if d <= 0 {
+		return 0
+	}
+	return int64((d + time.Second - 1) / time.Second)
+}
+
 func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
 	needSadd := true
 	now := time.Now().Unix()
@@ -181,8 +539,13 @@ func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
 
 type enqueueFnType func(*int64) (string, error)
 
-func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (enqueueFnType, *Job, error) {
+func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{}, opts UniqueOptions) (enqueueFnType, *Job, error) {
+	if err := opts.Mode.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	useDefaultKeys := false
+	keyMap := opts.KeyMap
 	if keyMap == nil {
 		useDefaultKeys = true
 		keyMap = args
@@ -200,6 +563,7 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 		Args:       args,
 		Unique:     true,
 		UniqueKey:  uniqueKey,
+		UniqueMode: opts.Mode,
 	}
 
 	rawJSON, err := job.serialize()
@@ -218,9 +582,10 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 		scriptArgs := []interface{}{}
 		script := e.enqueueUniqueScript
 
-		scriptArgs = append(scriptArgs, e.queuePrefix+jobName) // KEY[1]
-		scriptArgs = append(scriptArgs, uniqueKey)             // KEY[2]
-		scriptArgs = append(scriptArgs, rawJSON)               // ARGV[1]
+		scriptArgs = append(scriptArgs, e.queuePrefix+jobName)                           // KEY[1]
+		scriptArgs = append(scriptArgs, uniqueKey)                                       // KEY[2]
+		scriptArgs = append(scriptArgs, redisKeyJobsUniqueOptions(e.Namespace, jobName)) // KEY[3]
+		scriptArgs = append(scriptArgs, rawJSON)                                         // ARGV[1]
 		if useDefaultKeys {
 			// keying on arguments so arguments can't be updated
 			// we'll just get them off the original job so to save space, make this "1"
@@ -230,10 +595,12 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 			// doesn't get updated
 			scriptArgs = append(scriptArgs, rawJSON) // ARGV[2]
 		}
+		scriptArgs = append(scriptArgs, string(opts.Mode))           // ARGV[3]
+		scriptArgs = append(scriptArgs, int64(opts.TTL/time.Second)) // ARGV[4]
 
 		if runAt != nil { // Scheduled job so different job queue with additional arg
 			scriptArgs[0] = redisKeyScheduled(e.Namespace) // KEY[1]
-			scriptArgs = append(scriptArgs, *runAt)        // ARGV[3]
+			scriptArgs = append(scriptArgs, *runAt)        // ARGV[5]
 
 			script = e.enqueueUniqueInScript
 		}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gomodule/redigo/redis"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -231,6 +232,250 @@ func TestEnqueueUniqueIn(t *testing.T) {
 	assert.NotNil(t, job)
 }
 
+func TestEnqueueUniqueTTL(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	conn := pool.Get()
+	defer conn.Close()
+	enqueuer := NewEnqueuer(ns, pool)
+
+	ttl := func(job *Job) int64 {
+		v, err := redis.Int64(conn.Do("TTL", job.UniqueKey))
+		assert.NoError(t, err)
+		return v
+	}
+
+	job, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.True(t, ttl(job) > 86000)
+
+	job, err = enqueuer.EnqueueUniqueWithOptions("wat", Q{"a": 2}, UniqueOptions{TTL: time.Minute})
+	assert.NoError(t, err)
+	assert.True(t, ttl(job) > 0 && ttl(job) <= 60)
+
+	scheduledJob, err := enqueuer.EnqueueUniqueInWithOptions("wat", 10, Q{"a": 3}, UniqueOptions{TTL: time.Minute})
+	assert.NoError(t, err)
+	assert.True(t, ttl(scheduledJob.Job) > 0 && ttl(scheduledJob.Job) <= 60)
+
+	_, err = enqueuer.EnqueueUniqueWithOptions("wat", Q{"a": 4}, UniqueOptions{Mode: "wat"})
+	assert.Error(t, err)
+
+	// The worker pool tells enqueuers about the job type's defaults.
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("typed", JobOptions{UniqueTTL: time.Hour}, func(job *Job) error { return nil })
+	wp.Start()
+	wp.Stop()
+
+	job, err = enqueuer.EnqueueUnique("typed", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.True(t, ttl(job) > 3500 && ttl(job) <= 3600)
+
+	job, err = enqueuer.EnqueueUniqueWithOptions("typed", Q{"a": 2}, UniqueOptions{TTL: time.Minute})
+	assert.NoError(t, err)
+	assert.True(t, ttl(job) > 0 && ttl(job) <= 60)
+
+	// A job type with a unique mode but no TTL gets the default TTL, even if an older pool wrote a TTL of 0.
+	_, err = conn.Do("HMSET", redisKeyJobsUniqueOptions(ns, "moded"), "mode", "", "ttl", 0)
+	assert.NoError(t, err)
+	job, err = enqueuer.EnqueueUnique("moded", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.True(t, ttl(job) > 86000)
+
+	wp = NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("moded", JobOptions{UniqueMode: UniqueUntilComplete}, func(job *Job) error { return nil })
+	wp.Start()
+	wp.Stop()
+
+	job, err = enqueuer.EnqueueUnique("moded", Q{"a": 2})
+	assert.NoError(t, err)
+	assert.True(t, ttl(job) > 86000)
+	mode, err := redis.String(conn.Do("HGET", redisKeyJobsUniqueOptions(ns, "moded"), "mode"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(UniqueUntilComplete), mode)
+	exists, err := redis.Bool(conn.Do("HEXISTS", redisKeyJobsUniqueOptions(ns, "moded"), "ttl"))
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// The last pool to start wins.
+	wp = NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("typed", JobOptions{UniqueTTL: time.Minute}, func(job *Job) error { return nil })
+	wp.Start()
+	wp.Stop()
+
+	options, err := redis.StringMap(conn.Do("HGETALL", redisKeyJobsUniqueOptions(ns, "typed")))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"mode": "", "ttl": "60"}, options)
+}
+
+func TestEnqueueUniqueModes(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	// Each job tries to enqueue a duplicate of itself while it runs.
+	dups := map[string]bool{}
+	handler := func(job *Job) error {
+		dup, err := enqueuer.EnqueueUnique(job.Name, Q{"a": 1})
+		assert.NoError(t, err)
+		dups[job.Name] = dup != nil
+		return nil
+	}
+	jobTypes := map[string]*jobType{
+		"start":    {Name: "start", JobOptions: JobOptions{Priority: 1}, IsGeneric: true, GenericHandler: handler},
+		"complete": {Name: "complete", JobOptions: JobOptions{Priority: 1, UniqueMode: UniqueUntilComplete}, IsGeneric: true, GenericHandler: handler},
+	}
+
+	for name := range jobTypes {
+		job, err := enqueuer.EnqueueUniqueWithOptions(name, Q{"a": 1}, UniqueOptions{Mode: jobTypes[name].UniqueMode})
+		assert.NoError(t, err)
+		assert.NotNil(t, job)
+	}
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.processJob(fetchJobOf(t, w, "start"))
+	w.processJob(fetchJobOf(t, w, "complete"))
+
+	assert.Equal(t, map[string]bool{"start": true, "complete": false}, dups)
+
+	// Once the job is complete, it can be enqueued again.
+	job, err := enqueuer.EnqueueUnique("complete", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// Jobs unique while executing can be enqueued twice, but don't run at the same time.
+	job1, err := enqueuer.EnqueueUniqueWithOptions("executing", Q{"a": 1}, UniqueOptions{Mode: UniqueWhileExecuting})
+	assert.NoError(t, err)
+	assert.NotNil(t, job1)
+	job2, err := enqueuer.EnqueueUniqueWithOptions("executing", Q{"a": 1}, UniqueOptions{Mode: UniqueWhileExecuting})
+	assert.NoError(t, err)
+	assert.NotNil(t, job2)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "executing")))
+
+	var running, maxRunning int
+	jobTypes["executing"] = &jobType{Name: "executing", JobOptions: JobOptions{Priority: 1}, IsGeneric: true, GenericHandler: func(job *Job) error {
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		// The duplicate is picked up while this one runs.
+		w.processJob(fetchJobOf(t, w, "executing"))
+		running--
+		return nil
+	}}
+	w = newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.processJob(fetchJobOf(t, w, "executing"))
+
+	assert.Equal(t, 1, maxRunning)
+	score, delayed := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.EqualValues(t, 1425263409+10, score)
+	assert.Equal(t, UniqueWhileExecuting, delayed.UniqueMode)
+	assert.True(t, delayed.Unique)
+}
+
+func TestEnqueueDebounced(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	job, err := enqueuer.EnqueueDebounced("wat", "1", 10*time.Second, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1425263419, job.RunAt)
+
+	// Each call pushes the run back and replaces the arguments.
+	setNowEpochSecondsMock(1425263415)
+	job, err = enqueuer.EnqueueDebounced("wat", "1", 10*time.Second, Q{"a": 2})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1425263425, job.RunAt)
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+	score, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.EqualValues(t, 1425263425, score)
+	assert.Equal(t, job.ID, j.ID)
+	assert.EqualValues(t, 2, j.ArgInt64("a"))
+
+	// Other keys are debounced separately.
+	_, err = enqueuer.EnqueueDebounced("wat", "2", 500*time.Millisecond, Q{"a": 3})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+	score, j = jobOnZset(pool, redisKeyScheduled(ns))
+	assert.EqualValues(t, 1425263416, score)
+	assert.EqualValues(t, 3, j.ArgInt64("a"))
+
+	// Once the job left the scheduled queue, the next call enqueues a new one.
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("DEL", redisKeyScheduled(ns))
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueDebounced("wat", "1", 10*time.Second, Q{"a": 4})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+}
+
+func TestEnqueueThrottled(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	setNowEpochSecondsMock(1425263400)
+	defer resetNowEpochSecondsMock()
+
+	runAt := func(args Q) int64 {
+		job, err := enqueuer.EnqueueThrottled("wat", "1", time.Minute, args)
+		assert.NoError(t, err)
+		return job.RunAt
+	}
+
+	// The first call runs right away, later calls in the window collapse into a trailing run.
+	assert.EqualValues(t, 1425263400, runAt(Q{"a": 1}))
+	setNowEpochSecondsMock(1425263410)
+	assert.EqualValues(t, 1425263460, runAt(Q{"a": 2}))
+	setNowEpochSecondsMock(1425263420)
+	assert.EqualValues(t, 1425263460, runAt(Q{"a": 3}))
+
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("ZREMRANGEBYSCORE", redisKeyScheduled(ns), 0, 1425263400)
+	assert.NoError(t, err)
+	score, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.EqualValues(t, 1425263460, score)
+	assert.EqualValues(t, 3, j.ArgInt64("a"))
+
+	// After the trailing run, the next call waits for the window that started with it.
+	setNowEpochSecondsMock(1425263470)
+	assert.EqualValues(t, 1425263520, runAt(Q{"a": 4}))
+
+	// Once a whole window passed without a run, the job runs right away again.
+	setNowEpochSecondsMock(1425263600)
+	assert.EqualValues(t, 1425263600, runAt(Q{"a": 5}))
+}
+
+func fetchJobOf(t *testing.T, w *worker, jobName string) *Job {
+	for i := 0; i < 100; i++ {
+		job, err := w.fetchJob()
+		assert.NoError(t, err)
+		if job == nil || job.Name == jobName {
+			return job
+		}
+		// Put back jobs of other types.
+		w.removeJobFromInProgress(job, func(conn redis.Conn) {
+			conn.Send("RPUSH", job.dequeuedFrom, job.rawJSON)
+		})
+	}
+	t.Fatalf("no %s job", jobName)
+	return nil
+}
+
 func TestEnqueueUniqueByKey(t *testing.T) {
 	var arg3 string
 	var arg4 string
@@ -354,3 +599,31 @@ func EnqueueUniqueInByKey(t *testing.T) {
 	assert.NoError(t, j.ArgError())
 	assert.True(t, j.Unique)
 }
+
+func TestPushJobKeys(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	conn := pool.Get()
+	defer conn.Close()
+
+	// The Lua scripts derive the keys of groups, lanes and named queues from the job queue's key.
+	_, err := conn.Do("HSET", redisKeyJobsLanes(ns, "wat"), "high", 10)
+	assert.NoError(t, err)
+	for _, push := range []struct{ group, lane, queue string }{{"tenant1", "", ""}, {"", "high", ""}, {"", "", "reports"}} {
+		_, err := conn.Do("EVAL", redisLuaEnqueuePushJob, 1, redisKeyJobs(ns, "wat"), "{}", push.group, push.lane, push.queue)
+		assert.NoError(t, err)
+	}
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsGroup(ns, "wat", "tenant1")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsGroups(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsLane(ns, "wat", "high")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsQueue(ns, "wat", "reports")))
+	queues, err := redis.Strings(conn.Do("SMEMBERS", redisKeyJobsQueues(ns, "wat")))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"reports"}, queues)
+
+	// The fetch script derives the queue of a group from the list of groups.
+	assert.Equal(t, redisKeyJobsGroup(ns, "wat", "tenant1"), redisKeyJobsGroups(ns, "wat")+":tenant1")
+}
@@ -0,0 +1,177 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// healthFetchGrace is how much longer than its longest sleep backoff an idle worker can go without fetching before
+// it's considered stuck.
+const healthFetchGrace = 30 * time.Second
+
+// WorkerPoolStatus describes the health of a worker pool, see WorkerPool.Status.
+type WorkerPoolStatus struct {
+	WorkerPoolID string          `json:"worker_pool_id"`
+	Namespace    string          `json:"namespace"`
+	Healthy      bool            `json:"healthy"`            // Redis is reachable, and if the pool is started, it's beating and its workers are alive
+	Ready        bool            `json:"ready"`              // The pool is started, not quiet and not draining
+	Problems     []string        `json:"problems,omitempty"` // Why the pool isn't healthy or ready
+	Started      bool            `json:"started"`
+	Draining     bool            `json:"draining"`
+	Quiet        bool            `json:"quiet"`                  // See WorkerPool.Quiet
+	HeartbeatAt  int64           `json:"heartbeat_at,omitempty"` // When the last successful heartbeat was
+	Workers      []*WorkerStatus `json:"workers"`
+}
+
+// WorkerStatus describes what a worker of a pool is doing. The job fields are empty while it's idle.
+type WorkerStatus struct {
+	WorkerID         string `json:"worker_id"`
+	JobName          string `json:"job_name,omitempty"`
+	JobID            string `json:"job_id,omitempty"`
+	StartedAt        int64  `json:"started_at,omitempty"`
+	Checkin          string `json:"checkin,omitempty"`
+	CheckinAt        int64  `json:"checkin_at,omitempty"`
+	SinceLastFetchMs int64  `json:"since_last_fetch_ms"` // How long ago the worker last asked redis for a job, or -1 if it was never started
+}
+
+// Status checks that redis is reachable and returns the state of the pool and its workers. It doesn't wait for
+// anything the pool is doing, eg a drain.
+func (wp *WorkerPool) Status() *WorkerPoolStatus {
+	wp.statusMtx.Lock()
+	started, draining, quiet := wp.statusStarted, wp.statusDraining > 0, wp.statusQuiet
+	workers, heartbeaters := wp.statusWorkers, wp.statusHeartbeaters
+	wp.statusMtx.Unlock()
+
+	status := &WorkerPoolStatus{
+		WorkerPoolID: wp.workerPoolID,
+		Namespace:    wp.namespace,
+		Started:      started,
+		Draining:     draining,
+		Quiet:        quiet,
+		Workers:      make([]*WorkerStatus, 0, len(workers)),
+	}
+
+	var unhealthy, unready []string
+
+	conn := wp.pool.Get()
+	if _, err := conn.Do("PING"); err != nil {
+		unhealthy = append(unhealthy, fmt.Sprintf("redis is unreachable: %v", err))
+	}
+	conn.Close()
+
+	if started {
+		// A pool with several namespaces beats in each of them, and reports its oldest beat.
+		for i, heartbeater := range heartbeaters {
+			in := ""
+			if len(heartbeaters) > 1 {
+				in = " in namespace " + heartbeater.namespace
+			}
+			beatAt, err := heartbeater.lastBeat()
+			if i == 0 || beatAt < status.HeartbeatAt {
+				status.HeartbeatAt = beatAt
+			}
+			if err != nil {
+				unhealthy = append(unhealthy, fmt.Sprintf("the last heartbeat%s failed: %v", in, err))
+			} else if beatAt == 0 {
+				unhealthy = append(unhealthy, "the pool hasn't beat yet"+in)
+			} else if time.Duration(nowEpochSeconds()-beatAt)*time.Second > 3*heartbeater.beatPeriod {
+				unhealthy = append(unhealthy, "the heartbeat is stale"+in)
+			}
+		}
+	}
+
+	now := time.Now()
+	for _, w := range workers {
+		ws := &WorkerStatus{WorkerID: w.workerID, SinceLastFetchMs: -1}
+		var obv *observation
+		for _, nw := range w.all() {
+			if obv = nw.observer.currentObservation(); obv != nil {
+				break
+			}
+		}
+		if obv != nil {
+			ws.JobName = obv.jobName
+			ws.JobID = obv.jobID
+			ws.StartedAt = obv.startedAt
+			ws.Checkin = obv.checkin
+			ws.CheckinAt = obv.checkinAt
+		}
+		if lastFetch := w.lastFetch(); !lastFetch.IsZero() {
+			sinceLastFetch := now.Sub(lastFetch)
+			ws.SinceLastFetchMs = int64(sinceLastFetch / time.Millisecond)
+			// A worker processing a job doesn't fetch, however long the job takes, and neither does a quiet one.
+			if started && !quiet && obv == nil && sinceLastFetch > w.maxSleepBackoff()+healthFetchGrace {
+				unhealthy = append(unhealthy, fmt.Sprintf("worker %s hasn't fetched a job for %v", w.workerID, sinceLastFetch.Round(time.Second)))
+			}
+		}
+		status.Workers = append(status.Workers, ws)
+	}
+
+	if !started {
+		unready = append(unready, "the pool isn't started")
+	}
+	if quiet {
+		unready = append(unready, "the pool is quiet")
+	}
+	if draining {
+		unready = append(unready, "the pool is draining")
+	}
+
+	status.Healthy = len(unhealthy) == 0
+	status.Ready = started && !quiet && !draining
+	status.Problems = append(unhealthy, unready...)
+	return status
+}
+
+// HTTPHandler returns a handler for liveness and readiness probes. /healthz responds 200 if the pool is healthy, and
+// /readyz if it's ready, or 503 otherwise. Both respond with the pool's Status as JSON. To mount the handler under a
+// prefix, wrap it in http.StripPrefix.
+func (wp *WorkerPool) HTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(rw http.ResponseWriter, r *http.Request) {
+		status := wp.Status()
+		writeHealthStatus(rw, status, status.Healthy)
+	})
+	mux.HandleFunc("/readyz", func(rw http.ResponseWriter, r *http.Request) {
+		status := wp.Status()
+		writeHealthStatus(rw, status, status.Ready)
+	})
+	return mux
+}
+
+func writeHealthStatus(rw http.ResponseWriter, status *WorkerPoolStatus, ok bool) {
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if !ok {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(rw).Encode(status); err != nil {
+		logError("health.write_health_status", err)
+	}
+}
+
+// updateStatus copies the state reported by Status. Must be called with workersMtx held.
+func (wp *WorkerPool) updateStatus() {
+	wp.statusMtx.Lock()
+	defer wp.statusMtx.Unlock()
+	wp.statusStarted = wp.started
+	wp.statusQuiet = wp.quiet
+	wp.statusWorkers = append([]*worker(nil), wp.workers...)
+	wp.statusHeartbeaters = make([]*workerPoolHeartbeater, 0, len(wp.namespaces))
+	for _, ns := range wp.namespaces {
+		wp.statusHeartbeaters = append(wp.statusHeartbeaters, ns.heartbeater)
+	}
+}
+
+func (wp *WorkerPool) setDraining(delta int) {
+	wp.statusMtx.Lock()
+	defer wp.statusMtx.Unlock()
+	wp.statusDraining += delta
+}
+
+func (wp *WorkerPool) isDraining() bool {
+	wp.statusMtx.Lock()
+	defer wp.statusMtx.Unlock()
+	return wp.statusDraining > 0
+}
@@ -0,0 +1,137 @@
+package work
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPoolHTTPHandler(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job(job1, func(job *Job) error {
+		<-release
+		return nil
+	})
+	handler := wp.HTTPHandler()
+
+	code, status := getHealthStatus(t, handler, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, status.Healthy)
+	assert.False(t, status.Started)
+	assert.Equal(t, wp.workerPoolID, status.WorkerPoolID)
+	if assert.Len(t, status.Workers, 2) {
+		assert.EqualValues(t, -1, status.Workers[0].SinceLastFetchMs)
+	}
+
+	code, status = getHealthStatus(t, handler, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, status.Ready)
+	assert.Equal(t, []string{"the pool isn't started"}, status.Problems)
+
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+
+	// Wait for the first heartbeat and for a worker to start the job.
+	for {
+		status = wp.Status()
+		busy := 0
+		for _, ws := range status.Workers {
+			if ws.JobID != "" {
+				busy++
+			}
+		}
+		if status.HeartbeatAt != 0 && busy == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	code, status = getHealthStatus(t, handler, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, status.Healthy)
+	assert.Empty(t, status.Problems)
+	for _, ws := range status.Workers {
+		if ws.JobID != "" {
+			assert.Equal(t, job.ID, ws.JobID)
+			assert.Equal(t, job1, ws.JobName)
+		}
+		assert.True(t, ws.SinceLastFetchMs >= 0)
+	}
+
+	code, status = getHealthStatus(t, handler, "/readyz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, status.Ready)
+
+	// The pool isn't ready while it drains, and Status doesn't wait for the drain.
+	drained := make(chan struct{})
+	go func() {
+		wp.Drain()
+		close(drained)
+	}()
+	for !wp.Status().Draining {
+		time.Sleep(time.Millisecond)
+	}
+	code, status = getHealthStatus(t, handler, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, []string{"the pool is draining"}, status.Problems)
+
+	close(release)
+	<-drained
+	code, _ = getHealthStatus(t, handler, "/readyz")
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestWorkerPoolStatusStuckWorker(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	wp := setupTestWorkerPool(pool, ns, "job1", 1, JobOptions{})
+	wp.Start()
+	defer wp.Stop()
+
+	for wp.Status().HeartbeatAt == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	assert.True(t, wp.Status().Healthy)
+
+	// Keep the worker from fetching, as if its loop was stuck.
+	w := wp.workers[0]
+	atomic.StoreInt32(&w.stopping, 1)
+	time.Sleep(20 * time.Millisecond)
+	atomic.StoreInt64(&w.lastFetchAt, time.Now().Add(-time.Minute).UnixNano())
+
+	status := wp.Status()
+	assert.False(t, status.Healthy)
+	assert.True(t, status.Ready)
+	if assert.Len(t, status.Problems, 1) {
+		assert.Contains(t, status.Problems[0], "hasn't fetched a job for 1m0s")
+	}
+	assert.True(t, status.Workers[0].SinceLastFetchMs >= 60000)
+}
+
+func getHealthStatus(t *testing.T, handler http.Handler, path string) (int, *WorkerPoolStatus) {
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", path, nil)
+	handler.ServeHTTP(recorder, request)
+
+	var status WorkerPoolStatus
+	assert.Equal(t, "application/json; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+	return recorder.Code, &status
+}
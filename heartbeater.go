@@ -1,9 +1,11 @@
 package work
 
 import (
+	"encoding/json"
 	"os"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -11,6 +13,8 @@ import (
 
 const (
 	beatPeriod = 5 * time.Second
+	// How often the heartbeater looks for commands sent with Client.SendPoolCommand, which shouldn't wait for a beat.
+	commandPollPeriod = time.Second
 )
 
 type workerPoolHeartbeater struct {
@@ -18,37 +22,42 @@ type workerPoolHeartbeater struct {
 	namespace    string // eg, "myapp-work"
 	pool         *redis.Pool
 	beatPeriod   time.Duration
-	concurrency  uint
-	jobNames     string
+	pollPeriod   time.Duration // see commandPollPeriod
 	startedAt    int64
 	pid          int
 	hostname     string
-	workerIDs    string
+	labels       string // JSON, empty if the pool has no labels
+
+	runCommands func([]PoolCommand) // Runs the commands sent with Client.SendPoolCommand, if set. Called in a new goroutine.
+
+	// These change when job types are added or removed, or when the pool is scaled, while it's started.
+	mtx         sync.Mutex
+	jobNames    string
+	concurrency uint
+	workerIDs   string
+	quiet       bool
+
+	// The outcome of the last heartbeat, for WorkerPool.Status.
+	lastBeatAt  int64
+	lastBeatErr error
 
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
 }
 
-func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID string, jobTypes map[string]*jobType, concurrency uint, workerIDs []string) *workerPoolHeartbeater {
+func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID string, jobTypes map[string]*jobType, concurrency uint, workerIDs []string, labels map[string]string) *workerPoolHeartbeater {
 	h := &workerPoolHeartbeater{
 		workerPoolID:     workerPoolID,
 		namespace:        namespace,
 		pool:             pool,
 		beatPeriod:       beatPeriod,
-		concurrency:      concurrency,
+		pollPeriod:       commandPollPeriod,
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 	}
 
-	jobNames := make([]string, 0, len(jobTypes))
-	for k := range jobTypes {
-		jobNames = append(jobNames, k)
-	}
-	sort.Strings(jobNames)
-	h.jobNames = strings.Join(jobNames, ",")
-
-	sort.Strings(workerIDs)
-	h.workerIDs = strings.Join(workerIDs, ",")
+	h.setJobTypes(jobTypes)
+	h.setWorkers(concurrency, workerIDs)
 
 	h.pid = os.Getpid()
 	host, err := os.Hostname()
@@ -58,10 +67,50 @@ func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID s
 	}
 	h.hostname = host
 
+	if len(labels) > 0 {
+		labelsJSON, err := json.Marshal(labels)
+		if err != nil {
+			logError("heartbeat.labels", err)
+		} else {
+			h.labels = string(labelsJSON)
+		}
+	}
+
 	return h
 }
 
+// setJobTypes changes the job names reported from the next heartbeat on.
+func (h *workerPoolHeartbeater) setJobTypes(jobTypes map[string]*jobType) {
+	jobNames := make([]string, 0, len(jobTypes))
+	for k := range jobTypes {
+		jobNames = append(jobNames, k)
+	}
+	sort.Strings(jobNames)
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.jobNames = strings.Join(jobNames, ",")
+}
+
+// setWorkers changes the concurrency and worker IDs reported from the next heartbeat on.
+func (h *workerPoolHeartbeater) setWorkers(concurrency uint, workerIDs []string) {
+	sort.Strings(workerIDs)
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.concurrency = concurrency
+	h.workerIDs = strings.Join(workerIDs, ",")
+}
+
+// setQuiet changes whether the pool is reported as quiet from the next heartbeat on.
+func (h *workerPoolHeartbeater) setQuiet(quiet bool) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.quiet = quiet
+}
+
 func (h *workerPoolHeartbeater) start() {
+	h.startedAt = nowEpochSeconds()
 	go h.loop()
 }
 
@@ -71,9 +120,9 @@ func (h *workerPoolHeartbeater) stop() {
 }
 
 func (h *workerPoolHeartbeater) loop() {
-	h.startedAt = nowEpochSeconds()
 	h.heartbeat() // do it right away
 	ticker := time.Tick(h.beatPeriod)
+	commandTicker := time.Tick(h.pollPeriod)
 	for {
 		select {
 		case <-h.stopChan:
@@ -82,6 +131,8 @@ func (h *workerPoolHeartbeater) loop() {
 			return
 		case <-ticker:
 			h.heartbeat()
+		case <-commandTicker:
+			h.pollCommands()
 		}
 	}
 }
@@ -93,20 +144,62 @@ func (h *workerPoolHeartbeater) heartbeat() {
 	workerPoolsKey := redisKeyWorkerPools(h.namespace)
 	heartbeatKey := redisKeyHeartbeat(h.namespace, h.workerPoolID)
 
-	conn.Send("SADD", workerPoolsKey, h.workerPoolID)
-	conn.Send("HMSET", heartbeatKey,
+	h.mtx.Lock()
+	jobNames, concurrency, workerIDs, quiet := h.jobNames, h.concurrency, h.workerIDs, h.quiet
+	h.mtx.Unlock()
+
+	args := []interface{}{heartbeatKey,
 		"heartbeat_at", nowEpochSeconds(),
 		"started_at", h.startedAt,
-		"job_names", h.jobNames,
-		"concurrency", h.concurrency,
-		"worker_ids", h.workerIDs,
+		"job_names", jobNames,
+		"concurrency", concurrency,
+		"worker_ids", workerIDs,
 		"host", h.hostname,
 		"pid", h.pid,
-	)
+		"quiet", quiet,
+	}
+	if h.labels != "" {
+		args = append(args, "labels", h.labels)
+	}
 
-	if err := conn.Flush(); err != nil {
+	conn.Send("SADD", workerPoolsKey, h.workerPoolID)
+	conn.Send("HMSET", args...)
+
+	err := conn.Flush()
+	if err != nil {
 		logError("heartbeat", err)
 	}
+
+	h.mtx.Lock()
+	h.lastBeatErr = err
+	if err == nil {
+		h.lastBeatAt = nowEpochSeconds()
+	}
+	h.mtx.Unlock()
+}
+
+// pollCommands runs the commands sent to the pool since the last poll, if any.
+func (h *workerPoolHeartbeater) pollCommands() {
+	if h.runCommands == nil {
+		return
+	}
+
+	conn := h.pool.Get()
+	defer conn.Close()
+
+	commands, err := popPoolCommands(conn, h.namespace, h.workerPoolID)
+	if err != nil {
+		logError("heartbeat.pop_commands", err)
+	} else if len(commands) > 0 {
+		go h.runCommands(commands)
+	}
+}
+
+// lastBeat returns when the last successful heartbeat was, in epoch seconds, and the error of the last one if it failed.
+func (h *workerPoolHeartbeater) lastBeat() (int64, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	return h.lastBeatAt, h.lastBeatErr
 }
 
 func (h *workerPoolHeartbeater) removeHeartbeat() {
@@ -118,6 +211,7 @@ func (h *workerPoolHeartbeater) removeHeartbeat() {
 
 	conn.Send("SREM", workerPoolsKey, h.workerPoolID)
 	conn.Send("DEL", heartbeatKey)
+	conn.Send("DEL", redisKeyWorkerPoolCommands(h.namespace, h.workerPoolID))
 
 	if err := conn.Flush(); err != nil {
 		logError("remove_heartbeat", err)
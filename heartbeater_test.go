@@ -21,7 +21,7 @@ func TestHeartbeater(t *testing.T) {
 		"bar": nil,
 	}
 
-	heart := newWorkerPoolHeartbeater(ns, pool, "abcd", jobTypes, 10, []string{"ccc", "bbb"})
+	heart := newWorkerPoolHeartbeater(ns, pool, "abcd", jobTypes, 10, []string{"ccc", "bbb"}, map[string]string{"version": "1.4.2"})
 	heart.start()
 
 	time.Sleep(20 * time.Millisecond)
@@ -34,6 +34,7 @@ func TestHeartbeater(t *testing.T) {
 	assert.Equal(t, "bar,foo", h["job_names"])
 	assert.Equal(t, "bbb,ccc", h["worker_ids"])
 	assert.Equal(t, "10", h["concurrency"])
+	assert.Equal(t, `{"version":"1.4.2"}`, h["labels"])
 
 	assert.True(t, h["pid"] != "")
 	assert.True(t, h["host"] != "")
@@ -0,0 +1,272 @@
+package work
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// JanitorOptions make a worker pool look for stale keys left behind by job types and workers that are gone when it
+// starts, and clean them up. The janitor only knows about the worker pools that are running at the time, so enable it
+// once all pools have been deployed, or use DryRun to see what it would delete first.
+type JanitorOptions struct {
+	Enabled bool                 // If true, the janitor runs when the pool starts.
+	DryRun  bool                 // If true, stale keys are only reported.
+	Report  func(*JanitorReport) // Called with the stale keys that were found, if set.
+}
+
+// JanitorReport lists the stale keys the janitor found, and deleted unless DryRun is set.
+type JanitorReport struct {
+	DryRun     bool
+	KnownJobs  []string // Job names in known_jobs that no running pool has a handler for and that have no queued jobs.
+	JobKeys    []string // The keys of such job names that hold their options and state, eg their lock and paused keys.
+	UniqueKeys []string // Unique keys without an expiry that are older than the default unique TTL.
+	WorkerKeys []string // Observations of workers that no running pool has.
+}
+
+// janitorJobKeySuffixes are the per job type keys the janitor looks at, along with their redis type. Queues aren't
+// among them, since a job name with queued jobs isn't stale, and redis deletes empty lists. Neither are the no overlap
+// locks of unique keys, which expire by themselves.
+var janitorJobKeySuffixes = []struct {
+	suffix    string
+	redisType string
+}{
+	{":lock", "string"},
+	{":lock_info", "hash"},
+	{":max_concurrency", "string"},
+	{":paused", "string"},
+	{":priority", "string"},
+	{":lanes", "hash"},
+	{":queues", "set"},
+	{":group_lock", "hash"},
+	{":group_lock_info", "hash"},
+	{":max_group_concurrency", "string"},
+	{":group_turns", "string"},
+	{":rate_limit", "hash"},
+	{":unique", "hash"},
+	{":no_overlap", "string"},
+}
+
+type janitor struct {
+	namespace string
+	pool      *redis.Pool
+	jobNames  []string // of the pool running the janitor, which might not have beat yet
+	workerIDs []string
+}
+
+func newJanitor(namespace string, pool *redis.Pool, jobNames, workerIDs []string) *janitor {
+	return &janitor{
+		namespace: namespace,
+		pool:      pool,
+		jobNames:  jobNames,
+		workerIDs: workerIDs,
+	}
+}
+
+func (j *janitor) clean(dryRun bool) (*JanitorReport, error) {
+	handled := make(map[string]bool)
+	liveWorkers := make(map[string]bool)
+	for _, name := range j.jobNames {
+		handled[name] = true
+	}
+	for _, id := range j.workerIDs {
+		liveWorkers[id] = true
+	}
+
+	heartbeats, err := NewClient(j.namespace, j.pool).WorkerPoolHeartbeats()
+	if err != nil {
+		return nil, err
+	}
+	for _, heartbeat := range heartbeats {
+		for _, name := range heartbeat.JobNames {
+			handled[name] = true
+		}
+		for _, id := range heartbeat.WorkerIDs {
+			liveWorkers[id] = true
+		}
+	}
+
+	conn := j.pool.Get()
+	defer conn.Close()
+
+	stale := make(map[string]bool)
+	isStale := func(jobName string) (bool, error) {
+		if handled[jobName] {
+			return false, nil
+		}
+		if s, ok := stale[jobName]; ok {
+			return s, nil
+		}
+		queues, err := redisJobQueues(conn, j.namespace, jobName)
+		if err != nil {
+			return false, err
+		}
+		queued, err := redisAnyListNonEmpty(conn, queues)
+		if err != nil {
+			return false, err
+		}
+		stale[jobName] = !queued
+		return stale[jobName], nil
+	}
+
+	report := &JanitorReport{DryRun: dryRun}
+
+	knownJobs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(j.namespace)))
+	if err != nil {
+		return nil, err
+	}
+	for _, jobName := range knownJobs {
+		s, err := isStale(jobName)
+		if err != nil {
+			return nil, err
+		}
+		if s {
+			report.KnownJobs = append(report.KnownJobs, jobName)
+		}
+	}
+
+	jobsPrefix := redisKeyJobsPrefix(j.namespace)
+	for _, k := range janitorJobKeySuffixes {
+		keys, err := redisScanKeys(conn, redisEscapeGlob(jobsPrefix)+"*"+k.suffix)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			// Lane and group queues can end with the same suffixes, eg "jobs:foo:lanes:paused".
+			redisType, err := redis.String(conn.Do("TYPE", key))
+			if err != nil {
+				return nil, err
+			}
+			if redisType != k.redisType {
+				continue
+			}
+			s, err := isStale(strings.TrimSuffix(strings.TrimPrefix(key, jobsPrefix), k.suffix))
+			if err != nil {
+				return nil, err
+			}
+			if s {
+				report.JobKeys = append(report.JobKeys, key)
+			}
+		}
+	}
+
+	uniqueKeys, err := redisScanKeys(conn, redisEscapeGlob(redisNamespacePrefix(j.namespace))+"unique:*")
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range uniqueKeys {
+		expired, err := j.uniqueKeyExpired(conn, key)
+		if err != nil {
+			return nil, err
+		}
+		if expired {
+			report.UniqueKeys = append(report.UniqueKeys, key)
+		}
+	}
+
+	workerPrefix := redisKeyWorkerObservation(j.namespace, "")
+	workerKeys, err := redisScanKeys(conn, redisEscapeGlob(workerPrefix)+"*")
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range workerKeys {
+		if !liveWorkers[strings.TrimPrefix(key, workerPrefix)] {
+			report.WorkerKeys = append(report.WorkerKeys, key)
+		}
+	}
+
+	sort.Strings(report.KnownJobs)
+	sort.Strings(report.JobKeys)
+	sort.Strings(report.UniqueKeys)
+	sort.Strings(report.WorkerKeys)
+
+	if dryRun {
+		return report, nil
+	}
+
+	if len(report.KnownJobs) > 0 {
+		args := []interface{}{redisKeyKnownJobs(j.namespace)}
+		for _, jobName := range report.KnownJobs {
+			args = append(args, jobName)
+		}
+		if _, err := conn.Do("SREM", args...); err != nil {
+			return nil, err
+		}
+	}
+	for _, keys := range [][]string{report.JobKeys, report.UniqueKeys, report.WorkerKeys} {
+		for _, key := range keys {
+			if _, err := conn.Do("DEL", key); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// uniqueKeyExpired returns true for unique keys that redis won't expire by itself, as set by older versions, once
+// they're older than the default unique TTL. Keys without an enqueue time are always considered expired.
+func (j *janitor) uniqueKeyExpired(conn redis.Conn, key string) (bool, error) {
+	ttl, err := redis.Int64(conn.Do("PTTL", key))
+	if err != nil {
+		return false, err
+	}
+	if ttl != -1 {
+		return false, nil
+	}
+
+	rawJSON, err := redis.Bytes(conn.Do("GET", key))
+	if err == redis.ErrNil {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	job, err := newJob(rawJSON, nil, nil)
+	if err != nil || job.EnqueuedAt == 0 {
+		return true, nil
+	}
+	return nowEpochSeconds()-job.EnqueuedAt > int64(defaultUniqueTTL/time.Second), nil
+}
+
+// redisScanKeys returns the keys matching pattern without blocking redis like KEYS would.
+func redisScanKeys(conn redis.Conn, pattern string) ([]string, error) {
+	var keys []string
+	seen := make(map[string]bool)
+	cursor := "0"
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", pattern, "COUNT", 1000))
+		if err != nil {
+			return nil, err
+		}
+		var batch []string
+		if _, err := redis.Scan(values, &cursor, &batch); err != nil {
+			return nil, err
+		}
+		for _, key := range batch {
+			// SCAN can return a key more than once.
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+		if cursor == "0" {
+			return keys, nil
+		}
+	}
+}
+
+// redisEscapeGlob escapes the characters of s that are special in SCAN and KEYS patterns.
+func redisEscapeGlob(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
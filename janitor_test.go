@@ -0,0 +1,189 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJanitor(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "janitor"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	// Another running pool handles "other" with worker "w2".
+	_, err := conn.Do("SADD", redisKeyWorkerPools(ns), "p2")
+	assert.NoError(t, err)
+	_, err = conn.Do("HMSET", redisKeyHeartbeat(ns, "p2"), "job_names", "other", "worker_ids", "w2")
+	assert.NoError(t, err)
+
+	// "gone" has no handler and nothing queued. The others have no handler but a job queued in their queue, a group,
+	// a lane or a named queue.
+	waiting := []string{"waiting", "grouped", "laned", "named"}
+	_, err = conn.Do("SADD", redisKeyKnownJobs(ns), "mine", "other", "gone", "waiting", "grouped", "laned", "named")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobs(ns, "waiting"), `{"name":"waiting"}`)
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsGroups(ns, "grouped"), "g1")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsGroup(ns, "grouped", "g1"), `{"name":"grouped"}`)
+	assert.NoError(t, err)
+	// A lane named "paused" isn't a paused key.
+	_, err = conn.Do("HSET", redisKeyJobsLanes(ns, "laned"), "paused", 10)
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsLane(ns, "laned", "paused"), `{"name":"laned"}`)
+	assert.NoError(t, err)
+	_, err = conn.Do("SADD", redisKeyJobsQueues(ns, "named"), "q1")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsQueue(ns, "named", "q1"), `{"name":"named"}`)
+	assert.NoError(t, err)
+	for _, jobName := range append([]string{"mine", "gone"}, waiting...) {
+		_, err = conn.Do("SET", redisKeyJobsLock(ns, jobName), 1)
+		assert.NoError(t, err)
+		_, err = conn.Do("HSET", redisKeyJobsLockInfo(ns, jobName), "p1", 1)
+		assert.NoError(t, err)
+		_, err = conn.Do("SET", redisKeyJobsConcurrency(ns, jobName), 1)
+		assert.NoError(t, err)
+		_, err = conn.Do("SET", redisKeyJobsPaused(ns, jobName), 1)
+		assert.NoError(t, err)
+	}
+	for _, cmd := range [][]interface{}{
+		{"SET", redisKeyJobsPriority(ns, "gone"), 1},
+		{"HSET", redisKeyJobsLanes(ns, "gone"), "high", 10},
+		{"SADD", redisKeyJobsQueues(ns, "gone"), "q1"},
+		{"HSET", redisKeyJobsGroupLock(ns, "gone"), "g1", 1},
+		{"HSET", redisKeyJobsGroupLockInfo(ns, "gone"), "p1", 1},
+		{"SET", redisKeyJobsGroupConcurrency(ns, "gone"), 1},
+		{"SET", redisKeyJobsGroupTurns(ns, "gone"), 1},
+		{"HSET", redisKeyJobsRateLimit(ns, "gone"), "limit", 1},
+		{"HSET", redisKeyJobsUniqueOptions(ns, "gone"), "mode", "until_executed"},
+		{"SET", redisKeyJobsNoOverlap(ns, "gone"), "token"},
+	} {
+		_, err = conn.Do(cmd[0].(string), cmd[1:]...)
+		assert.NoError(t, err)
+	}
+
+	uniqueKey := func(name string) string {
+		key, err := redisKeyUniqueJob(ns, name, nil)
+		assert.NoError(t, err)
+		return key
+	}
+	_, err = conn.Do("SET", uniqueKey("ttl"), "1", "EX", 60)
+	assert.NoError(t, err)
+	_, err = conn.Do("SET", uniqueKey("legacy"), "1")
+	assert.NoError(t, err)
+	_, err = conn.Do("SET", uniqueKey("recent"), `{"name":"recent","t":1425263400}`)
+	assert.NoError(t, err)
+	_, err = conn.Do("SET", uniqueKey("old"), `{"name":"old","t":1425000000}`)
+	assert.NoError(t, err)
+
+	for _, id := range []string{"w1", "w2", "w3"} {
+		_, err = conn.Do("HSET", redisKeyWorkerObservation(ns, id), "job_name", "mine")
+		assert.NoError(t, err)
+	}
+
+	expected := &JanitorReport{
+		DryRun:    true,
+		KnownJobs: []string{"gone"},
+		JobKeys: []string{
+			redisKeyJobsGroupLock(ns, "gone"),
+			redisKeyJobsGroupLockInfo(ns, "gone"),
+			redisKeyJobsGroupTurns(ns, "gone"),
+			redisKeyJobsLanes(ns, "gone"),
+			redisKeyJobsLock(ns, "gone"),
+			redisKeyJobsLockInfo(ns, "gone"),
+			redisKeyJobsConcurrency(ns, "gone"),
+			redisKeyJobsGroupConcurrency(ns, "gone"),
+			redisKeyJobsNoOverlap(ns, "gone"),
+			redisKeyJobsPaused(ns, "gone"),
+			redisKeyJobsPriority(ns, "gone"),
+			redisKeyJobsQueues(ns, "gone"),
+			redisKeyJobsRateLimit(ns, "gone"),
+			redisKeyJobsUniqueOptions(ns, "gone"),
+		},
+		UniqueKeys: []string{uniqueKey("legacy"), uniqueKey("old")},
+		WorkerKeys: []string{redisKeyWorkerObservation(ns, "w3")},
+	}
+
+	j := newJanitor(ns, pool, []string{"mine"}, []string{"w1"})
+	report, err := j.clean(true)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, report)
+	assert.Len(t, knownJobs(pool, redisKeyKnownJobs(ns)), 7)
+	assert.EqualValues(t, 1, getInt64(pool, redisKeyJobsLock(ns, "gone")))
+
+	report, err = j.clean(false)
+	assert.NoError(t, err)
+	expected.DryRun = false
+	assert.Equal(t, expected, report)
+
+	assert.Equal(t, []string{"grouped", "laned", "mine", "named", "other", "waiting"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+	for _, key := range append(append(expected.JobKeys, expected.UniqueKeys...), expected.WorkerKeys...) {
+		exists, err := redis.Bool(conn.Do("EXISTS", key))
+		assert.NoError(t, err)
+		assert.False(t, exists, key)
+	}
+	kept := []string{
+		redisKeyJobsPaused(ns, "mine"),
+		redisKeyJobsLanes(ns, "laned"),
+		redisKeyJobsLane(ns, "laned", "paused"),
+		redisKeyJobsQueues(ns, "named"),
+		uniqueKey("ttl"),
+		uniqueKey("recent"),
+		redisKeyWorkerObservation(ns, "w1"),
+		redisKeyWorkerObservation(ns, "w2"),
+	}
+	for _, jobName := range waiting {
+		kept = append(kept, redisKeyJobsPaused(ns, jobName))
+	}
+	for _, key := range kept {
+		exists, err := redis.Bool(conn.Do("EXISTS", key))
+		assert.NoError(t, err)
+		assert.True(t, exists, key)
+	}
+
+	// Nothing's left the second time around.
+	report, err = j.clean(false)
+	assert.NoError(t, err)
+	assert.Equal(t, &JanitorReport{}, report)
+}
+
+func TestWorkerPoolJanitor(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "janitor"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SET", redisKeyJobsPaused(ns, "gone"), 1)
+	assert.NoError(t, err)
+
+	reports := make(chan *JanitorReport, 1)
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{
+		Janitor: JanitorOptions{Enabled: true, DryRun: true, Report: func(r *JanitorReport) { reports <- r }},
+	})
+	wp.Job("mine", func(*Job) error { return nil })
+	wp.Start()
+	defer wp.Stop()
+
+	select {
+	case report := <-reports:
+		assert.True(t, report.DryRun)
+		assert.Equal(t, []string{redisKeyJobsPaused(ns, "gone")}, report.JobKeys)
+		assert.Empty(t, report.WorkerKeys)
+	case <-time.After(time.Second):
+		t.Fatal("the janitor didn't report")
+	}
+}
+
+func TestRedisEscapeGlob(t *testing.T) {
+	assert.Equal(t, `{ns}:a\*b\?c\[d\]e\\f`, redisEscapeGlob(`{ns}:a*b?c[d]e\f`))
+}
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -16,6 +17,10 @@ type Job struct {
 	Args       map[string]interface{} `json:"args"`
 	Unique     bool                   `json:"unique,omitempty"`
 	UniqueKey  string                 `json:"unique_key,omitempty"`
+	UniqueMode UniqueMode             `json:"unique_mode,omitempty"` // set if given to EnqueueUniqueWithOptions
+	Group      string                 `json:"group,omitempty"`       // set if given to EnqueueForGroup
+	Lane       string                 `json:"lane,omitempty"`        // set if given to EnqueueToLane
+	Queue      string                 `json:"queue,omitempty"`       // set if given to EnqueueTo
 
 	// Inputs when retrying
 	Fails    int64  `json:"fails,omitempty"` // number of times this job has failed
@@ -27,6 +32,7 @@ type Job struct {
 	inProgQueue  []byte
 	argError     error
 	observer     *observer
+	ctx          context.Context
 }
 
 // Q is a shortcut to easily specify arguments for jobs when enqueueing them.
@@ -45,6 +51,15 @@ func newJob(rawJSON, dequeuedFrom, inProgQueue []byte) (*Job, error) {
 	return &job, nil
 }
 
+// Context returns a context that's canceled when the worker pool gives up on the job, eg because StopWithContext ran
+// out of time and handed the job back to its queue. Jobs that run for a long time should stop when it's done.
+func (j *Job) Context() context.Context {
+	if j.ctx == nil {
+		return context.Background()
+	}
+	return j.ctx
+}
+
 func (j *Job) serialize() ([]byte, error) {
 	return json.Marshal(j)
 }
@@ -0,0 +1,101 @@
+package work
+
+import (
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	defaultNoOverlapLease      = time.Minute
+	defaultWhileExecutingDelay = 10 * time.Second
+)
+
+// noOverlapLock is a lease-based lock held while a job with JobOptions.NoOverlap runs. The lease is renewed in the
+// background, so the lock only outlives the job if the worker pool dies, and then only until the lease runs out.
+type noOverlapLock struct {
+	pool  *redis.Pool
+	key   string
+	token string
+	lease time.Duration
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+func noOverlapLockKey(namespace string, jt *jobType, job *Job, uniqueMode UniqueMode) string {
+	byKey := jt.NoOverlapByKey || (job.Unique && uniqueMode == UniqueWhileExecuting)
+	if byKey && job.UniqueKey != "" {
+		return job.UniqueKey + ":no_overlap"
+	}
+	return redisKeyJobsNoOverlap(namespace, job.Name)
+}
+
+// acquireNoOverlapLock returns nil if another job holds the lock.
+func acquireNoOverlapLock(pool *redis.Pool, key string, lease time.Duration) (*noOverlapLock, error) {
+	if lease <= 0 {
+		lease = defaultNoOverlapLease
+	}
+
+	l := &noOverlapLock{
+		pool:             pool,
+		key:              key,
+		token:            makeIdentifier(),
+		lease:            lease,
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	_, err := redis.String(conn.Do("SET", key, l.token, "PX", int64(lease/time.Millisecond), "NX"))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	go l.loop()
+
+	return l, nil
+}
+
+func (l *noOverlapLock) loop() {
+	ticker := time.NewTicker(l.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stopChan:
+			l.doneStoppingChan <- struct{}{}
+			return
+		case <-ticker.C:
+			if err := l.renew(); err != nil {
+				logError("no_overlap_lock.renew", err)
+			}
+		}
+	}
+}
+
+func (l *noOverlapLock) renew() error {
+	conn := l.pool.Get()
+	defer conn.Close()
+
+	script := redis.NewScript(1, redisLuaRenewLockCmd)
+	_, err := script.Do(conn, l.key, l.token, int64(l.lease/time.Millisecond))
+	return err
+}
+
+func (l *noOverlapLock) release() {
+	l.stopChan <- struct{}{}
+	<-l.doneStoppingChan
+
+	conn := l.pool.Get()
+	defer conn.Close()
+
+	script := redis.NewScript(1, redisLuaReleaseLockCmd)
+	if _, err := script.Do(conn, l.key, l.token); err != nil {
+		logError("no_overlap_lock.release", err)
+	}
+}
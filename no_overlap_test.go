@@ -0,0 +1,58 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoOverlapLock(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	key := redisKeyJobsNoOverlap(ns, "wat")
+
+	lock, err := acquireNoOverlapLock(pool, key, 300*time.Millisecond)
+	assert.NoError(t, err)
+	assert.NotNil(t, lock)
+
+	other, err := acquireNoOverlapLock(pool, key, 300*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Nil(t, other)
+
+	// The lease is renewed while the lock is held.
+	time.Sleep(500 * time.Millisecond)
+	other, err = acquireNoOverlapLock(pool, key, 300*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Nil(t, other)
+
+	lock.release()
+	other, err = acquireNoOverlapLock(pool, key, 300*time.Millisecond)
+	assert.NoError(t, err)
+	if assert.NotNil(t, other) {
+		other.release()
+	}
+
+	// The lock has a lease, so a lock left behind by a dead worker pool runs out.
+	lock, err = acquireNoOverlapLock(pool, key, 300*time.Millisecond)
+	assert.NoError(t, err)
+	if !assert.NotNil(t, lock) {
+		return
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+	ttl, err := redis.Int64(conn.Do("PTTL", key))
+	assert.NoError(t, err)
+	assert.True(t, ttl > 0 && ttl <= 300)
+
+	// Releasing doesn't delete a lock that has since been taken by someone else.
+	_, err = conn.Do("SET", key, "someone else")
+	assert.NoError(t, err)
+	lock.release()
+	v, err := redis.String(conn.Do("GET", key))
+	assert.NoError(t, err)
+	assert.Equal(t, "someone else", v)
+}
@@ -3,6 +3,7 @@ package work
 import (
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -14,6 +15,9 @@ type observer struct {
 	workerID  string
 	pool      *redis.Pool
 
+	// mtx guards currentStartedObservation, which only the loop changes, so that currentObservation can read it.
+	mtx sync.Mutex
+
 	// nil: worker isn't doing anything that we know of
 	// not nil: the last started observation that we received on the channel.
 	// if we get an checkin, we'll just update the existing observation
@@ -120,6 +124,18 @@ func (o *observer) observeCheckin(jobName, jobID, checkin string) {
 	}
 }
 
+// currentObservation returns a copy of the observation of the job the worker is processing, or nil if it's idle.
+func (o *observer) currentObservation() *observation {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	if o.currentStartedObservation == nil {
+		return nil
+	}
+	obv := *o.currentStartedObservation
+	return &obv
+}
+
 func (o *observer) loop() {
 	// Every tick we'll update redis if necessary
 	// We don't update it on every job because the only purpose of this data is for humans to inspect the system,
@@ -159,6 +175,7 @@ func (o *observer) loop() {
 }
 
 func (o *observer) process(obv *observation) {
+	o.mtx.Lock()
 	if obv.kind == observationKindStarted {
 		o.currentStartedObservation = obv
 	} else if obv.kind == observationKindDone {
@@ -171,6 +188,7 @@ func (o *observer) process(obv *observation) {
 			logError("observer.checkin_mismatch", fmt.Errorf("got checkin but mismatch on job ID or no job"))
 		}
 	}
+	o.mtx.Unlock()
 	o.version++
 
 	// If this is the version observation we got, just go ahead and write it.
@@ -1,6 +1,9 @@
 package work
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"time"
@@ -24,9 +27,22 @@ type periodicEnqueuer struct {
 }
 
 type periodicJob struct {
-	jobName  string
-	spec     string
-	schedule cron.Schedule
+	jobName     string
+	spec        string
+	schedule    cron.Schedule
+	args        map[string]interface{}
+	argsHash    string // empty if there are no args
+	scheduleID  string // set for schedules stored in redis, see PeriodicSchedule
+	misfire     MisfirePolicy
+	maxMisfires uint
+}
+
+// periodicFireTimes is stored per periodic job in the periodic_fire_times hash so that the Client can report when it
+// last fired, and so that firings missed while no worker pool was running can be caught up on.
+type periodicFireTimes struct {
+	Last     int64   `json:"last,omitempty"`
+	Upcoming []int64 `json:"upcoming,omitempty"`
+	Until    int64   `json:"until,omitempty"` // firings before this have been scheduled
 }
 
 type scheduledPeriodicJob struct {
@@ -91,21 +107,40 @@ func (pe *periodicEnqueuer) enqueue() error {
 	conn := pe.pool.Get()
 	defer conn.Close()
 
-	for _, pj := range pe.periodicJobs {
-		for t := pj.schedule.Next(nowTime); t.Before(horizon); t = pj.schedule.Next(t) {
-			epoch := t.Unix()
-			id := makeUniquePeriodicID(pj.jobName, pj.spec, epoch)
+	periodicJobs, err := pe.loadPeriodicJobs(conn)
+	if err != nil {
+		return err
+	}
+
+	fireTimes, err := getPeriodicFireTimes(conn, pe.namespace, periodicJobs)
+	if err != nil {
+		return err
+	}
+
+	for i, pj := range periodicJobs {
+		ft := &periodicFireTimes{Last: fireTimes[i].lastAt(now), Until: horizon.Unix()}
+
+		// Missed firings are scheduled in the past, so the requeuer picks them up right away.
+		for _, epoch := range pj.missedFirings(fireTimes[i].Until, now) {
+			rawJSON, err := pj.job(epoch).serialize()
+			if err != nil {
+				return err
+			}
 
-			job := &Job{
-				Name: pj.jobName,
-				ID:   id,
+			_, err = conn.Do("ZADD", redisKeyScheduled(pe.namespace), epoch, rawJSON)
+			if err != nil {
+				return err
+			}
 
-				// This is technically wrong, but this lets the bytes be identical for the same periodic job instance. If we don't do this, we'd need to use a different approach -- probably giving each periodic job its own history of the past 100 periodic jobs, and only scheduling a job if it's not in the history.
-				EnqueuedAt: epoch,
-				Args:       nil,
+			if epoch > ft.Last {
+				ft.Last = epoch
 			}
+		}
+
+		for t := pj.schedule.Next(nowTime); t.Before(horizon); t = pj.schedule.Next(t) {
+			epoch := t.Unix()
 
-			rawJSON, err := job.serialize()
+			rawJSON, err := pj.job(epoch).serialize()
 			if err != nil {
 				return err
 			}
@@ -114,14 +149,44 @@ func (pe *periodicEnqueuer) enqueue() error {
 			if err != nil {
 				return err
 			}
+
+			ft.Upcoming = append(ft.Upcoming, epoch)
+		}
+
+		if err := setPeriodicFireTimes(conn, pe.namespace, pj.key(), ft); err != nil {
+			return err
 		}
 	}
 
-	_, err := conn.Do("SET", redisKeyLastPeriodicEnqueue(pe.namespace), now)
+	_, err = conn.Do("SET", redisKeyLastPeriodicEnqueue(pe.namespace), now)
 
 	return err
 }
 
+// loadPeriodicJobs returns the periodic jobs registered with the worker pool followed by the enabled schedules stored in redis.
+func (pe *periodicEnqueuer) loadPeriodicJobs(conn redis.Conn) ([]*periodicJob, error) {
+	schedules, err := getPeriodicSchedules(conn, pe.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	periodicJobs := make([]*periodicJob, 0, len(pe.periodicJobs)+len(schedules))
+	periodicJobs = append(periodicJobs, pe.periodicJobs...)
+	for _, s := range schedules {
+		if s.Disabled {
+			continue
+		}
+		pj, err := s.periodicJob()
+		if err != nil {
+			logError("periodic_enqueuer.load_periodic_jobs", err)
+			continue
+		}
+		periodicJobs = append(periodicJobs, pj)
+	}
+
+	return periodicJobs, nil
+}
+
 func (pe *periodicEnqueuer) shouldEnqueue() bool {
 	conn := pe.pool.Get()
 	defer conn.Close()
@@ -137,6 +202,132 @@ func (pe *periodicEnqueuer) shouldEnqueue() bool {
 	return lastEnqueue < (nowEpochSeconds() - int64(periodicEnqueuerSleep/time.Minute))
 }
 
-func makeUniquePeriodicID(name, spec string, epoch int64) string {
-	return fmt.Sprintf("periodic:%s:%s:%d", name, spec, epoch)
+// key identifies the periodic job across worker pools.
+func (pj *periodicJob) key() string {
+	if pj.scheduleID != "" {
+		return pj.scheduleID
+	}
+	if pj.argsHash == "" {
+		return pj.jobName + ":" + pj.spec
+	}
+	return pj.jobName + ":" + pj.spec + ":" + pj.argsHash
+}
+
+func (pj *periodicJob) job(epoch int64) *Job {
+	id := makeUniquePeriodicID(pj.jobName, pj.spec, pj.argsHash, epoch)
+	if pj.scheduleID != "" {
+		id = fmt.Sprintf("periodic:%s:%d", pj.scheduleID, epoch)
+	}
+
+	return &Job{
+		Name: pj.jobName,
+		ID:   id,
+
+		// This is technically wrong, but this lets the bytes be identical for the same periodic job instance. If we don't do this, we'd need to use a different approach -- probably giving each periodic job its own history of the past 100 periodic jobs, and only scheduling a job if it's not in the history.
+		EnqueuedAt: epoch,
+		Args:       pj.args,
+	}
+}
+
+// missedFirings returns the firings between until and now which weren't scheduled, as allowed by the misfire policy.
+func (pj *periodicJob) missedFirings(until, now int64) []int64 {
+	if until == 0 || until > now {
+		return nil
+	}
+
+	var limit int
+	switch pj.misfire {
+	case MisfireRunOnce:
+		limit = 1
+	case MisfireRunAll:
+		limit = defaultMaxMisfires
+		if pj.maxMisfires > 0 {
+			limit = int(pj.maxMisfires)
+		}
+	default:
+		return nil
+	}
+
+	var epochs []int64
+	for t := pj.schedule.Next(time.Unix(until-1, 0)); !t.IsZero() && t.Unix() <= now; t = pj.schedule.Next(t) {
+		epochs = append(epochs, t.Unix())
+		if len(epochs) > limit {
+			epochs = epochs[1:]
+		}
+	}
+	return epochs
+}
+
+// lastAt returns the last time the periodic job fired as of now.
+func (ft *periodicFireTimes) lastAt(now int64) int64 {
+	last := ft.Last
+	for _, epoch := range ft.Upcoming {
+		if epoch <= now && epoch > last {
+			last = epoch
+		}
+	}
+	return last
+}
+
+func getPeriodicFireTimes(conn redis.Conn, namespace string, periodicJobs []*periodicJob) ([]*periodicFireTimes, error) {
+	fireTimes := make([]*periodicFireTimes, len(periodicJobs))
+	if len(periodicJobs) == 0 {
+		return fireTimes, nil
+	}
+
+	args := make([]interface{}, 0, len(periodicJobs)+1)
+	args = append(args, redisKeyPeriodicFireTimes(namespace))
+	for _, pj := range periodicJobs {
+		args = append(args, pj.key())
+	}
+
+	values, err := redis.ByteSlices(conn.Do("HMGET", args...))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range values {
+		fireTimes[i] = &periodicFireTimes{}
+		if v == nil {
+			continue
+		}
+		if err := json.Unmarshal(v, fireTimes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return fireTimes, nil
+}
+
+func setPeriodicFireTimes(conn redis.Conn, namespace, key string, ft *periodicFireTimes) error {
+	rawJSON, err := json.Marshal(ft)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("HSET", redisKeyPeriodicFireTimes(namespace), key, rawJSON)
+	return err
+}
+
+func makeUniquePeriodicID(name, spec, argsHash string, epoch int64) string {
+	if argsHash == "" {
+		return fmt.Sprintf("periodic:%s:%s:%d", name, spec, epoch)
+	}
+	return fmt.Sprintf("periodic:%s:%s:%s:%d", name, spec, argsHash, epoch)
+}
+
+// periodicArgsHash returns a short digest of args so that the same job scheduled with different args gets distinct IDs.
+// encoding/json sorts map keys, so every pool computes the same hash for the same args.
+func periodicArgsHash(args map[string]interface{}) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:8]), nil
 }
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -97,6 +98,170 @@ func TestPeriodicEnqueuer(t *testing.T) {
 	assert.True(t, pe.shouldEnqueue())
 }
 
+func TestPeriodicEnqueuerWithArgs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.PeriodicallyEnqueueWithOptions("0 * * * * *", "report", Q{"region": "eu"}, PeriodicJobOptions{})
+	wp.PeriodicallyEnqueueWithOptions("0 * * * * *", "report", Q{"region": "us"}, PeriodicJobOptions{})
+
+	setNowEpochSecondsMock(1468359453)
+	defer resetNowEpochSecondsMock()
+
+	pe := newPeriodicEnqueuer(ns, pool, wp.periodicJobs)
+	err := pe.enqueue()
+	assert.NoError(t, err)
+
+	// A second pool with the same schedule enqueues the exact same jobs
+	wp2 := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp2.PeriodicallyEnqueueWithOptions("0 * * * * *", "report", Q{"region": "us"}, PeriodicJobOptions{})
+	err = newPeriodicEnqueuer(ns, pool, wp2.periodicJobs).enqueue()
+	assert.NoError(t, err)
+
+	c := NewClient(ns, pool)
+	scheduledJobs, count, err := c.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 8, count)
+
+	regions := map[string]int{}
+	ids := map[string]bool{}
+	for _, j := range scheduledJobs {
+		assert.Equal(t, "report", j.Name)
+		regions[j.ArgString("region")]++
+		ids[j.ID] = true
+	}
+	assert.Equal(t, map[string]int{"eu": 4, "us": 4}, regions)
+	assert.Equal(t, 8, len(ids))
+
+	euHash, err := periodicArgsHash(Q{"region": "eu"})
+	assert.NoError(t, err)
+	assert.Equal(t, "periodic:report:0 * * * * *:"+euHash+":1468359480", makeUniquePeriodicID("report", "0 * * * * *", euHash, 1468359480))
+}
+
+func TestPeriodicEnqueuerDynamicSchedules(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1468359453)
+	defer resetNowEpochSecondsMock()
+
+	c := NewClient(ns, pool)
+	s, err := c.AddPeriodicSchedule("report", "0 * * * * *", Q{"region": "eu"})
+	assert.NoError(t, err)
+	disabled, err := c.AddPeriodicSchedule("cleanup", "0 * * * * *", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, c.DisablePeriodicSchedule(disabled.ID))
+
+	pe := newPeriodicEnqueuer(ns, pool, nil)
+	assert.NoError(t, pe.enqueue())
+
+	scheduledJobs, count, err := c.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, count)
+	for i, j := range scheduledJobs {
+		epoch := int64(1468359480 + 60*i)
+		assert.Equal(t, "report", j.Name)
+		assert.Equal(t, "eu", j.ArgString("region"))
+		assert.Equal(t, fmt.Sprintf("periodic:%s:%d", s.ID, epoch), j.ID)
+		assert.Equal(t, epoch, j.RunAt)
+	}
+
+	schedules, err := c.PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(schedules))
+	assert.Equal(t, "cleanup", schedules[0].JobName)
+	assert.EqualValues(t, 0, schedules[0].NextFireAt)
+	assert.Equal(t, "report", schedules[1].JobName)
+	assert.EqualValues(t, 0, schedules[1].LastFireAt)
+	assert.EqualValues(t, 1468359480, schedules[1].NextFireAt)
+
+	// Time passes and the first two jobs fire. Changing the schedule unschedules the other two.
+	setNowEpochSecondsMock(1468359545)
+	schedules, err = c.PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1468359540, schedules[1].LastFireAt)
+	assert.EqualValues(t, 1468359600, schedules[1].NextFireAt)
+
+	s.Spec = "0 0 * * * *"
+	assert.NoError(t, c.UpdatePeriodicSchedule(s))
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+
+	assert.NoError(t, pe.enqueue())
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+
+	schedules, err = c.PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1468359540, schedules[1].LastFireAt)
+	assert.EqualValues(t, 1468360800, schedules[1].NextFireAt)
+}
+
+func TestPeriodicEnqueuerMisfire(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	conn := pool.Get()
+	defer conn.Close()
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.PeriodicallyEnqueue("0 * * * * *", "skipped")
+	wp.PeriodicallyEnqueueWithOptions("0 * * * * *", "once", nil, PeriodicJobOptions{Misfire: MisfireRunOnce})
+	wp.PeriodicallyEnqueueWithOptions("0 * * * * *", "all", nil, PeriodicJobOptions{Misfire: MisfireRunAll, MaxMisfires: 3})
+
+	c := NewClient(ns, pool)
+	dynamic, err := c.AddPeriodicScheduleWithOptions("dynamic", "0 * * * * *", nil, PeriodicJobOptions{Misfire: MisfireRunAll})
+	assert.NoError(t, err)
+
+	setNowEpochSecondsMock(1468359453)
+	defer resetNowEpochSecondsMock()
+
+	pe := newPeriodicEnqueuer(ns, pool, wp.periodicJobs)
+	assert.NoError(t, pe.enqueue())
+	assert.EqualValues(t, 16, zsetSize(pool, redisKeyScheduled(ns)))
+
+	// Pretend the scheduled jobs ran, then all pools are down for 10 minutes.
+	_, err = conn.Do("DEL", redisKeyScheduled(ns))
+	assert.NoError(t, err)
+	setNowEpochSecondsMock(1468360053)
+	assert.NoError(t, pe.enqueue())
+
+	missed := func(name string) []int64 {
+		var epochs []int64
+		jobs, _, err := c.ScheduledJobs(1)
+		assert.NoError(t, err)
+		for _, j := range jobs {
+			if j.Name == name && j.RunAt <= 1468360053 {
+				epochs = append(epochs, j.RunAt)
+			}
+		}
+		return epochs
+	}
+	assert.Nil(t, missed("skipped"))
+	assert.Equal(t, []int64{1468360020}, missed("once"))
+	assert.Equal(t, []int64{1468359900, 1468359960, 1468360020}, missed("all"))
+	assert.Equal(t, []int64{1468359720, 1468359780, 1468359840, 1468359900, 1468359960, 1468360020}, missed("dynamic"))
+
+	schedules, err := c.PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1468360020, schedules[0].LastFireAt)
+
+	// Nothing more is caught up on the next run.
+	count := zsetSize(pool, redisKeyScheduled(ns))
+	assert.NoError(t, pe.enqueue())
+	assert.EqualValues(t, count, zsetSize(pool, redisKeyScheduled(ns)))
+
+	// Changes to a schedule don't catch up on firings of the old version.
+	_, err = conn.Do("DEL", redisKeyScheduled(ns))
+	assert.NoError(t, err)
+	assert.NoError(t, c.DisablePeriodicSchedule(dynamic.ID))
+	setNowEpochSecondsMock(1468360653)
+	assert.NoError(t, c.EnablePeriodicSchedule(dynamic.ID))
+	assert.NoError(t, pe.enqueue())
+	assert.Nil(t, missed("dynamic"))
+}
+
 func TestPeriodicEnqueuerSpawn(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
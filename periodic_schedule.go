@@ -0,0 +1,205 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/robfig/cron"
+)
+
+// PeriodicSchedule is a periodic job stored in redis. Unlike periodic jobs registered with WorkerPool.PeriodicallyEnqueue,
+// they can be added, changed and deleted at runtime through the Client. Worker pools pick up changes the next time they
+// enqueue periodic jobs.
+type PeriodicSchedule struct {
+	ID       string                 `json:"id"`
+	JobName  string                 `json:"job_name"`
+	Spec     string                 `json:"spec"`
+	Args     map[string]interface{} `json:"args,omitempty"`
+	Disabled bool                   `json:"disabled,omitempty"`
+
+	Misfire     MisfirePolicy `json:"misfire,omitempty"`
+	MaxMisfires uint          `json:"max_misfires,omitempty"`
+
+	// These are filled in by Client.PeriodicSchedules and aren't stored.
+	LastFireAt int64 `json:"last_fire_at,omitempty"`
+	NextFireAt int64 `json:"next_fire_at,omitempty"` // zero if the schedule is disabled
+}
+
+func (s *PeriodicSchedule) validate() error {
+	if s.JobName == "" {
+		return fmt.Errorf("work: periodic schedule needs a job name")
+	}
+	if err := s.Misfire.validate(); err != nil {
+		return err
+	}
+	_, err := parsePeriodicSpec(s.Spec, nil)
+	return err
+}
+
+func (s *PeriodicSchedule) serialize() ([]byte, error) {
+	stored := *s
+	stored.LastFireAt = 0
+	stored.NextFireAt = 0
+	return json.Marshal(&stored)
+}
+
+func (s *PeriodicSchedule) periodicJob() (*periodicJob, error) {
+	schedule, err := parsePeriodicSpec(s.Spec, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &periodicJob{
+		jobName:     s.JobName,
+		spec:        s.Spec,
+		schedule:    schedule,
+		args:        s.Args,
+		scheduleID:  s.ID,
+		misfire:     s.Misfire,
+		maxMisfires: s.MaxMisfires,
+	}, nil
+}
+
+// getPeriodicSchedules returns the schedules stored in redis ordered by job name and ID.
+func getPeriodicSchedules(conn redis.Conn, namespace string) ([]*PeriodicSchedule, error) {
+	values, err := redis.ByteSlices(conn.Do("HVALS", redisKeyPeriodicSchedules(namespace)))
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*PeriodicSchedule, 0, len(values))
+	for _, v := range values {
+		var s PeriodicSchedule
+		if err := json.Unmarshal(v, &s); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, &s)
+	}
+
+	sort.Slice(schedules, func(i, j int) bool {
+		if schedules[i].JobName != schedules[j].JobName {
+			return schedules[i].JobName < schedules[j].JobName
+		}
+		return schedules[i].ID < schedules[j].ID
+	})
+
+	return schedules, nil
+}
+
+func getPeriodicSchedule(conn redis.Conn, namespace, id string) (*PeriodicSchedule, error) {
+	v, err := redis.Bytes(conn.Do("HGET", redisKeyPeriodicSchedules(namespace), id))
+	if err != nil {
+		return nil, err
+	}
+
+	var s PeriodicSchedule
+	if err := json.Unmarshal(v, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// parsePeriodicSpec parses a cron spec as used by PeriodicallyEnqueue. The spec may start with a "CRON_TZ=<zone>" or
+// "TZ=<zone>" prefix, eg "CRON_TZ=Europe/Berlin 0 30 9 * * *", in which case it's evaluated in that time zone.
+// Otherwise it's evaluated in loc, or in the local time zone if loc is nil.
+func parsePeriodicSpec(spec string, loc *time.Location) (cron.Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.Index(spec, " ")
+		if i == -1 {
+			return nil, fmt.Errorf("work: missing cron spec after time zone in %q", spec)
+		}
+		eq := strings.Index(spec, "=")
+		zone, err := time.LoadLocation(spec[eq+1 : i])
+		if err != nil {
+			return nil, fmt.Errorf("work: invalid time zone in %q: %v", spec, err)
+		}
+		loc = zone
+		spec = strings.TrimSpace(spec[i:])
+	}
+
+	schedule, err := cron.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Constant delay schedules such as "@every 1h" don't depend on the time zone.
+	ss, ok := schedule.(*cron.SpecSchedule)
+	if loc == nil || !ok {
+		return schedule, nil
+	}
+
+	return &locationSchedule{schedule: ss, loc: loc, everyHour: ss.Hour&allHours == allHours}, nil
+}
+
+const allHours = 1<<24 - 1
+
+// locationSchedule evaluates a cron schedule in a fixed time zone, taking care of DST transitions. When the clock falls
+// back, a wall-clock time that happens twice only fires once, unless the schedule fires every hour. When the clock
+// springs forward, times that are skipped fire right after the jump instead of being lost.
+type locationSchedule struct {
+	schedule  cron.Schedule
+	loc       *time.Location
+	everyHour bool
+}
+
+func (s *locationSchedule) Next(t time.Time) time.Time {
+	t = t.In(s.loc)
+
+	next := s.schedule.Next(t)
+	for !next.IsZero() && !s.everyHour && s.isRepeatedWallClock(next) {
+		next = s.schedule.Next(next)
+	}
+
+	// Look for a firing that falls into a spring-forward gap by evaluating the schedule on the wall clock alone.
+	wallNext := s.schedule.Next(wallClock(t))
+	if !wallNext.IsZero() && (next.IsZero() || wallNext.Before(wallClock(next))) {
+		if jump, ok := s.gapEnd(wallNext); ok && jump.After(t) && (next.IsZero() || jump.Before(next)) {
+			next = jump
+		}
+	}
+
+	return next
+}
+
+// isRepeatedWallClock returns true if the wall-clock time of t already happened once before, ie t is in the second
+// pass through an hour repeated by a fall-back transition.
+func (s *locationSchedule) isRepeatedWallClock(t time.Time) bool {
+	_, offset := t.Zone()
+	_, prevOffset := t.Add(-24 * time.Hour).Zone()
+	if prevOffset <= offset {
+		return false
+	}
+	earlier := t.Add(-time.Duration(prevOffset-offset) * time.Second)
+	return wallClock(earlier).Equal(wallClock(t))
+}
+
+// gapEnd checks if the wall-clock time w (expressed in UTC) doesn't exist in s.loc because the clock jumped over it.
+// If so, it returns the instant right after the jump.
+func (s *locationSchedule) gapEnd(w time.Time) (time.Time, bool) {
+	r := time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, s.loc)
+	if wallClock(r).Equal(w) {
+		return time.Time{}, false
+	}
+
+	// Binary search for the first second whose wall clock is past w.
+	lo, hi := r.Add(-3*time.Hour), r.Add(3*time.Hour)
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+		if wallClock(mid).Before(w) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi.In(s.loc), true
+}
+
+// wallClock returns the wall-clock time of t as if it were a UTC time, so that it can be compared without DST effects.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,124 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func firings(t *testing.T, spec string, loc *time.Location, from time.Time, n int) []string {
+	schedule, err := parsePeriodicSpec(spec, loc)
+	assert.NoError(t, err)
+
+	var res []string
+	next := from
+	for i := 0; i < n; i++ {
+		next = schedule.Next(next)
+		res = append(res, next.Format("2006-01-02 15:04:05 MST"))
+	}
+	return res
+}
+
+func TestParsePeriodicSpecTimeZone(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []string{"2024-06-01 09:00:00 EDT"}, firings(t, "CRON_TZ=America/New_York 0 0 9 * * *", nil, from, 1))
+	assert.Equal(t, []string{"2024-06-01 09:00:00 CEST"}, firings(t, "TZ=Europe/Berlin 0 0 9 * * *", nil, from, 1))
+	assert.Equal(t, []string{"2024-06-01 09:00:00 EDT"}, firings(t, "0 0 9 * * *", ny, from, 1))
+
+	// The prefix takes precedence over the location option.
+	assert.Equal(t, []string{"2024-06-01 09:00:00 CEST"}, firings(t, "CRON_TZ=Europe/Berlin 0 0 9 * * *", ny, from, 1))
+
+	_, err = parsePeriodicSpec("CRON_TZ=Nowhere/Special 0 0 9 * * *", nil)
+	assert.Error(t, err)
+	_, err = parsePeriodicSpec("CRON_TZ=America/New_York", nil)
+	assert.Error(t, err)
+}
+
+func TestPeriodicScheduleSpringForward(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	// 02:30 doesn't exist on 2024-03-10 in New York, so it fires right after the jump.
+	assert.Equal(t, []string{
+		"2024-03-09 02:30:00 EST",
+		"2024-03-10 03:00:00 EDT",
+		"2024-03-11 02:30:00 EDT",
+	}, firings(t, "0 30 2 * * *", ny, time.Date(2024, 3, 9, 0, 0, 0, 0, ny), 3))
+
+	// Hourly jobs fire once per elapsed hour.
+	assert.Equal(t, []string{
+		"2024-03-10 01:00:00 EST",
+		"2024-03-10 03:00:00 EDT",
+		"2024-03-10 04:00:00 EDT",
+	}, firings(t, "0 0 * * * *", ny, time.Date(2024, 3, 10, 0, 30, 0, 0, ny), 3))
+
+	// Every 15 minutes in the skipped hour collapses to a single firing at the jump.
+	assert.Equal(t, []string{
+		"2024-03-31 01:45:00 CET",
+		"2024-03-31 03:00:00 CEST",
+		"2024-03-31 03:15:00 CEST",
+	}, firings(t, "0 */15 * * * *", berlin, time.Date(2024, 3, 31, 1, 30, 0, 0, berlin), 3))
+}
+
+func TestPeriodicScheduleFallBack(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	// 01:30 happens twice on 2024-11-03 in New York, but the job only fires once.
+	assert.Equal(t, []string{
+		"2024-11-02 01:30:00 EDT",
+		"2024-11-03 01:30:00 EDT",
+		"2024-11-04 01:30:00 EST",
+	}, firings(t, "0 30 1 * * *", ny, time.Date(2024, 11, 2, 0, 0, 0, 0, ny), 3))
+
+	// Starting in the repeated hour after the first firing doesn't fire again.
+	repeated := time.Date(2024, 11, 3, 5, 45, 0, 0, time.UTC) // 01:45 EDT
+	assert.Equal(t, []string{"2024-11-04 01:30:00 EST"}, firings(t, "0 30 1 * * *", ny, repeated, 1))
+
+	// Hourly jobs keep firing each elapsed hour.
+	assert.Equal(t, []string{
+		"2024-11-03 01:00:00 EDT",
+		"2024-11-03 01:00:00 EST",
+		"2024-11-03 02:00:00 EST",
+	}, firings(t, "0 0 * * * *", ny, time.Date(2024, 11, 3, 0, 30, 0, 0, ny), 3))
+
+	// Every 15 minutes skips the whole repeated hour.
+	firstPass := time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC) // 02:30 CEST
+	assert.Equal(t, []string{
+		"2024-10-27 02:45:00 CEST",
+		"2024-10-27 03:00:00 CET",
+	}, firings(t, "0 */15 2-3 * * *", berlin, firstPass, 2))
+}
+
+func TestPeriodicEnqueuerTimeZone(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	schedule, err := parsePeriodicSpec("CRON_TZ=Asia/Tokyo 0 0 9 * * *", nil)
+	assert.NoError(t, err)
+	pjs := []*periodicJob{{jobName: "foo", spec: "CRON_TZ=Asia/Tokyo 0 0 9 * * *", schedule: schedule}}
+
+	// 2024-06-01 00:00:00 UTC is 09:00 in Tokyo.
+	setNowEpochSecondsMock(time.Date(2024, 5, 31, 23, 58, 0, 0, time.UTC).Unix())
+	defer resetNowEpochSecondsMock()
+
+	pe := newPeriodicEnqueuer(ns, pool, pjs)
+	assert.NoError(t, pe.enqueue())
+
+	c := NewClient(ns, pool)
+	jobs, count, err := c.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Unix(), jobs[0].RunAt)
+	}
+}
@@ -0,0 +1,163 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// poolCommandTTL is how long commands wait for a pool to pick them up, so that commands sent to a pool that died don't
+// pile up.
+const poolCommandTTL = time.Minute
+
+// ErrPoolNotFound is returned by SendPoolCommand if there's no running worker pool with the given ID.
+var ErrPoolNotFound = fmt.Errorf("worker pool not found")
+
+// PoolCommandName names a command that can be sent to a running worker pool, see Client.SendPoolCommand.
+type PoolCommandName string
+
+const (
+	// PoolCommandQuiet makes the pool stop fetching jobs, see WorkerPool.Quiet.
+	PoolCommandQuiet PoolCommandName = "quiet"
+	// PoolCommandResume makes a quiet pool fetch jobs again, see WorkerPool.Resume.
+	PoolCommandResume PoolCommandName = "resume"
+	// PoolCommandStop stops the pool, see WorkerPool.Stop. The process it runs in keeps running.
+	PoolCommandStop PoolCommandName = "stop"
+	// PoolCommandSetConcurrency changes the number of workers of the pool, see WorkerPool.SetConcurrency.
+	PoolCommandSetConcurrency PoolCommandName = "set_concurrency"
+)
+
+// PoolCommand is a command sent to a running worker pool.
+type PoolCommand struct {
+	Name        PoolCommandName `json:"name"`
+	Concurrency uint            `json:"concurrency,omitempty"` // For PoolCommandSetConcurrency
+}
+
+func (c PoolCommand) validate() error {
+	switch c.Name {
+	case PoolCommandQuiet, PoolCommandResume, PoolCommandStop:
+		return nil
+	case PoolCommandSetConcurrency:
+		if c.Concurrency == 0 {
+			return fmt.Errorf("work: set_concurrency needs a concurrency of at least 1")
+		}
+		return nil
+	}
+	return fmt.Errorf("work: unknown pool command %q", c.Name)
+}
+
+// SendPoolCommand sends a command to the running worker pool with the given ID, as listed by WorkerPoolHeartbeats.
+// The pool checks for commands every second, between heartbeats. Commands that aren't picked up within a minute are
+// dropped.
+func (c *Client) SendPoolCommand(workerPoolID string, command PoolCommand) error {
+	if err := command.validate(); err != nil {
+		return err
+	}
+
+	rawJSON, err := json.Marshal(command)
+	if err != nil {
+		return err
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	running, err := redis.Bool(conn.Do("SISMEMBER", redisKeyWorkerPools(c.namespace), workerPoolID))
+	if err != nil {
+		logError("client.send_pool_command.sismember", err)
+		return err
+	}
+	if !running {
+		return ErrPoolNotFound
+	}
+
+	key := redisKeyWorkerPoolCommands(c.namespace, workerPoolID)
+	conn.Send("MULTI")
+	conn.Send("RPUSH", key, rawJSON)
+	conn.Send("PEXPIRE", key, int64(poolCommandTTL/time.Millisecond))
+	if _, err := conn.Do("EXEC"); err != nil {
+		logError("client.send_pool_command.rpush", err)
+		return err
+	}
+	return nil
+}
+
+// popPoolCommands takes the commands sent to a worker pool, oldest first.
+func popPoolCommands(conn redis.Conn, namespace, workerPoolID string) ([]PoolCommand, error) {
+	key := redisKeyWorkerPoolCommands(namespace, workerPoolID)
+	conn.Send("MULTI")
+	conn.Send("LRANGE", key, 0, -1)
+	conn.Send("DEL", key)
+	values, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return nil, err
+	}
+
+	rawCommands, err := redis.ByteSlices(values[0], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	commands := make([]PoolCommand, 0, len(rawCommands))
+	for _, rawJSON := range rawCommands {
+		var command PoolCommand
+		if err := json.Unmarshal(rawJSON, &command); err != nil {
+			logError("pool_command.unmarshal", err)
+			continue
+		}
+		commands = append(commands, command)
+	}
+	return commands, nil
+}
+
+// runPoolCommands runs the commands sent to the pool in order. It runs in its own goroutine, since the stop command
+// waits for the heartbeater that received it to stop.
+func (wp *WorkerPool) runPoolCommands(commands []PoolCommand) {
+	for _, command := range commands {
+		if err := command.validate(); err != nil {
+			logError("worker_pool.pool_command", err)
+			continue
+		}
+
+		switch command.Name {
+		case PoolCommandQuiet:
+			wp.Quiet()
+		case PoolCommandResume:
+			wp.Resume()
+		case PoolCommandStop:
+			wp.Stop()
+		case PoolCommandSetConcurrency:
+			wp.SetConcurrency(command.Concurrency)
+		}
+	}
+}
+
+// Quiet makes the workers stop fetching jobs, eg before a deploy, while the jobs they're processing finish. The pool
+// stays started and keeps beating, but isn't ready as reported by Status until Resume is called.
+func (wp *WorkerPool) Quiet() {
+	wp.setQuiet(true)
+}
+
+// Resume makes the workers of a quiet pool fetch jobs again.
+func (wp *WorkerPool) Resume() {
+	wp.setQuiet(false)
+}
+
+func (wp *WorkerPool) setQuiet(quiet bool) {
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
+
+	wp.quiet = quiet
+	for _, w := range wp.workers {
+		w.setQuiet(quiet)
+	}
+	wp.updateStatus()
+	if wp.started {
+		for _, ns := range wp.namespaces {
+			ns.heartbeater.setQuiet(quiet)
+			ns.heartbeater.heartbeat()
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientSendPoolCommand(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	client := NewClient(ns, pool)
+
+	err := client.SendPoolCommand("nope", PoolCommand{Name: PoolCommandQuiet})
+	assert.Equal(t, ErrPoolNotFound, err)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), "abcd")
+	assert.NoError(t, err)
+
+	assert.Error(t, client.SendPoolCommand("abcd", PoolCommand{Name: "restart"}))
+	assert.Error(t, client.SendPoolCommand("abcd", PoolCommand{Name: PoolCommandSetConcurrency}))
+
+	assert.NoError(t, client.SendPoolCommand("abcd", PoolCommand{Name: PoolCommandQuiet}))
+	assert.NoError(t, client.SendPoolCommand("abcd", PoolCommand{Name: PoolCommandSetConcurrency, Concurrency: 3}))
+
+	key := redisKeyWorkerPoolCommands(ns, "abcd")
+	ttl, err := redis.Int64(conn.Do("PTTL", key))
+	assert.NoError(t, err)
+	assert.True(t, ttl > 0 && ttl <= int64(poolCommandTTL/time.Millisecond))
+
+	commands, err := popPoolCommands(conn, ns, "abcd")
+	assert.NoError(t, err)
+	assert.Equal(t, []PoolCommand{{Name: PoolCommandQuiet}, {Name: PoolCommandSetConcurrency, Concurrency: 3}}, commands)
+	assert.EqualValues(t, 0, listSize(pool, key))
+
+	commands, err = popPoolCommands(conn, ns, "abcd")
+	assert.NoError(t, err)
+	assert.Empty(t, commands)
+}
+
+func TestWorkerPoolCommands(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+	wp := setupTestWorkerPool(pool, ns, job1, 1, JobOptions{})
+	wp.Start()
+	defer wp.Stop()
+
+	client := NewClient(ns, pool)
+	for !redisInSet(pool, redisKeyWorkerPools(ns), wp.workerPoolID) {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The heartbeater checks for commands every second, which the test doesn't wait for.
+	send := func(command PoolCommand) {
+		assert.NoError(t, client.SendPoolCommand(wp.workerPoolID, command))
+		wp.namespaces[0].heartbeater.pollCommands()
+	}
+
+	send(PoolCommand{Name: PoolCommandQuiet})
+	for !wp.Status().Quiet {
+		time.Sleep(time.Millisecond)
+	}
+	assert.False(t, wp.Status().Ready)
+	hbs, err := client.WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	if assert.Len(t, hbs, 1) {
+		assert.True(t, hbs[0].Quiet)
+	}
+
+	// A quiet pool doesn't fetch jobs, and is drained.
+	_, err = NewEnqueuer(ns, pool).Enqueue(job1, Q{"sleep": 0})
+	assert.NoError(t, err)
+	wp.Drain()
+	time.Sleep(50 * time.Millisecond)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+
+	send(PoolCommand{Name: PoolCommandResume})
+	for listSize(pool, redisKeyJobs(ns, job1)) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	assert.False(t, wp.Status().Quiet)
+	hbs, err = client.WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	if assert.Len(t, hbs, 1) {
+		assert.False(t, hbs[0].Quiet)
+	}
+
+	send(PoolCommand{Name: PoolCommandSetConcurrency, Concurrency: 3})
+	for wp.Concurrency() != 3 {
+		time.Sleep(time.Millisecond)
+	}
+
+	send(PoolCommand{Name: PoolCommandStop})
+	for wp.Status().Started {
+		time.Sleep(time.Millisecond)
+	}
+	assert.False(t, redisInSet(pool, redisKeyWorkerPools(ns), wp.workerPoolID))
+}
@@ -2,11 +2,13 @@ package work
 
 import (
 	"math/rand"
+	"sort"
 )
 
 type prioritySampler struct {
 	sum     uint
 	samples []sampleItem
+	strict  bool // If true, higher priorities always go first, see WorkerPoolOptions.StrictPriority
 }
 
 type sampleItem struct {
@@ -19,9 +21,16 @@ type sampleItem struct {
 	redisJobsLock           string
 	redisJobsLockInfo       string
 	redisJobsMaxConcurrency string
+	redisJobsRateLimit      string
+
+	redisJobsGroups              string
+	redisJobsGroupLock           string
+	redisJobsGroupLockInfo       string
+	redisJobsMaxGroupConcurrency string
+	redisJobsGroupTurns          string
 }
 
-func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency string) {
+func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency, redisJobsRateLimit, redisJobsGroups, redisJobsGroupLock, redisJobsGroupLockInfo, redisJobsMaxGroupConcurrency, redisJobsGroupTurns string) {
 	sample := sampleItem{
 		priority:                priority,
 		redisJobs:               redisJobs,
@@ -30,6 +39,13 @@ func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJo
 		redisJobsLock:           redisJobsLock,
 		redisJobsLockInfo:       redisJobsLockInfo,
 		redisJobsMaxConcurrency: redisJobsMaxConcurrency,
+		redisJobsRateLimit:      redisJobsRateLimit,
+
+		redisJobsGroups:              redisJobsGroups,
+		redisJobsGroupLock:           redisJobsGroupLock,
+		redisJobsGroupLockInfo:       redisJobsGroupLockInfo,
+		redisJobsMaxGroupConcurrency: redisJobsMaxGroupConcurrency,
+		redisJobsGroupTurns:          redisJobsGroupTurns,
 	}
 	s.samples = append(s.samples, sample)
 	s.sum += priority
@@ -76,5 +92,12 @@ func (s *prioritySampler) sample() []sampleItem {
 		remaining--
 	}
 
+	// Items with the same priority keep their random order.
+	if s.strict {
+		sort.SliceStable(s.samples, func(i, j int) bool {
+			return s.samples[i].priority > s.samples[j].priority
+		})
+	}
+
 	return s.samples
 }
@@ -10,9 +10,9 @@ import (
 func TestPrioritySampler(t *testing.T) {
 	ps := prioritySampler{}
 
-	ps.add(5, "jobs.5", "jobsinprog.5", "jobspaused.5", "jobslock.5", "jobslockinfo.5", "jobsconcurrency.5")
-	ps.add(2, "jobs.2a", "jobsinprog.2a", "jobspaused.2a", "jobslock.2a", "jobslockinfo.2a", "jobsconcurrency.2a")
-	ps.add(1, "jobs.1b", "jobsinprog.1b", "jobspaused.1b", "jobslock.1b", "jobslockinfo.1b", "jobsconcurrency.1b")
+	ps.add(5, "jobs.5", "jobsinprog.5", "jobspaused.5", "jobslock.5", "jobslockinfo.5", "jobsconcurrency.5", "jobsratelimit.5", "jobsgroups.5", "jobsgrouplock.5", "jobsgrouplockinfo.5", "jobsgroupconcurrency.5", "jobsgroupturns.5")
+	ps.add(2, "jobs.2a", "jobsinprog.2a", "jobspaused.2a", "jobslock.2a", "jobslockinfo.2a", "jobsconcurrency.2a", "jobsratelimit.2a", "jobsgroups.2a", "jobsgrouplock.2a", "jobsgrouplockinfo.2a", "jobsgroupconcurrency.2a", "jobsgroupturns.2a")
+	ps.add(1, "jobs.1b", "jobsinprog.1b", "jobspaused.1b", "jobslock.1b", "jobslockinfo.1b", "jobsconcurrency.1b", "jobsratelimit.1b", "jobsgroups.1b", "jobsgrouplock.1b", "jobsgrouplockinfo.1b", "jobsgroupconcurrency.1b", "jobsgroupturns.1b")
 
 	var c5 = 0
 	var c2 = 0
@@ -40,6 +40,31 @@ func TestPrioritySampler(t *testing.T) {
 	assert.True(t, float64(c1end) > (float64(total)*0.50))
 }
 
+func TestPrioritySamplerStrict(t *testing.T) {
+	ps := prioritySampler{strict: true}
+
+	ps.add(1, "jobs.1", "jobsinprog.1", "jobspaused.1", "jobslock.1", "jobslockinfo.1", "jobsconcurrency.1", "jobsratelimit.1", "jobsgroups.1", "jobsgrouplock.1", "jobsgrouplockinfo.1", "jobsgroupconcurrency.1", "jobsgroupturns.1")
+	ps.add(2, "jobs.2a", "jobsinprog.2a", "jobspaused.2a", "jobslock.2a", "jobslockinfo.2a", "jobsconcurrency.2a", "jobsratelimit.2a", "jobsgroups.2a", "jobsgrouplock.2a", "jobsgrouplockinfo.2a", "jobsgroupconcurrency.2a", "jobsgroupturns.2a")
+	ps.add(5, "jobs.5", "jobsinprog.5", "jobspaused.5", "jobslock.5", "jobslockinfo.5", "jobsconcurrency.5", "jobsratelimit.5", "jobsgroups.5", "jobsgrouplock.5", "jobsgrouplockinfo.5", "jobsgroupconcurrency.5", "jobsgroupturns.5")
+	ps.add(2, "jobs.2b", "jobsinprog.2b", "jobspaused.2b", "jobslock.2b", "jobslockinfo.2b", "jobsconcurrency.2b", "jobsratelimit.2b", "jobsgroups.2b", "jobsgrouplock.2b", "jobsgrouplockinfo.2b", "jobsgroupconcurrency.2b", "jobsgroupturns.2b")
+
+	var c2a = 0
+	var total = 200
+	for i := 0; i < total; i++ {
+		ret := ps.sample()
+		assert.Equal(t, "jobs.5", ret[0].redisJobs)
+		assert.EqualValues(t, 2, ret[1].priority)
+		assert.EqualValues(t, 2, ret[2].priority)
+		assert.Equal(t, "jobs.1", ret[3].redisJobs)
+		if ret[1].redisJobs == "jobs.2a" {
+			c2a++
+		}
+	}
+
+	// Queues with the same priority still take turns.
+	assert.True(t, c2a > total/4 && c2a < total*3/4, fmt.Sprintf("c2a = %d total = %d", c2a, total))
+}
+
 func BenchmarkPrioritySampler(b *testing.B) {
 	ps := prioritySampler{}
 	for i := 0; i < 200; i++ {
@@ -49,7 +74,13 @@ func BenchmarkPrioritySampler(b *testing.B) {
 			"jobspaused."+fmt.Sprint(i),
 			"jobslock."+fmt.Sprint(i),
 			"jobslockinfo."+fmt.Sprint(i),
-			"jobsmaxconcurrency."+fmt.Sprint(i))
+			"jobsmaxconcurrency."+fmt.Sprint(i),
+			"jobsratelimit."+fmt.Sprint(i),
+			"jobsgroups."+fmt.Sprint(i),
+			"jobsgrouplock."+fmt.Sprint(i),
+			"jobsgrouplockinfo."+fmt.Sprint(i),
+			"jobsmaxgroupconcurrency."+fmt.Sprint(i),
+			"jobsgroupturns."+fmt.Sprint(i))
 	}
 
 	b.ResetTimer()
@@ -56,6 +56,10 @@ func redisKeyHeartbeat(namespace, workerPoolID string) string {
 	return redisNamespacePrefix(namespace) + "worker_pools:" + workerPoolID
 }
 
+func redisKeyWorkerPoolCommands(namespace, workerPoolID string) string {
+	return redisKeyHeartbeat(namespace, workerPoolID) + ":commands"
+}
+
 func redisKeyJobsPaused(namespace, jobName string) string {
 	return redisKeyJobs(namespace, jobName) + ":paused"
 }
@@ -72,6 +76,62 @@ func redisKeyJobsConcurrency(namespace, jobName string) string {
 	return redisKeyJobs(namespace, jobName) + ":max_concurrency"
 }
 
+func redisKeyJobsPriority(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":priority"
+}
+
+func redisKeyJobsLanes(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":lanes"
+}
+
+func redisKeyJobsLane(namespace, jobName, lane string) string {
+	return redisKeyJobsLanes(namespace, jobName) + ":" + lane
+}
+
+func redisKeyJobsQueues(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":queues"
+}
+
+func redisKeyJobsQueue(namespace, jobName, queue string) string {
+	return redisKeyJobsQueues(namespace, jobName) + ":" + queue
+}
+
+func redisKeyJobsGroups(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":groups"
+}
+
+func redisKeyJobsGroup(namespace, jobName, group string) string {
+	return redisKeyJobsGroups(namespace, jobName) + ":" + group
+}
+
+func redisKeyJobsGroupLock(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":group_lock"
+}
+
+func redisKeyJobsGroupLockInfo(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":group_lock_info"
+}
+
+func redisKeyJobsGroupConcurrency(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":max_group_concurrency"
+}
+
+func redisKeyJobsGroupTurns(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":group_turns"
+}
+
+func redisKeyJobsRateLimit(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":rate_limit"
+}
+
+func redisKeyJobsUniqueOptions(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":unique"
+}
+
+func redisKeyJobsNoOverlap(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":no_overlap"
+}
+
 func redisKeyUniqueJob(namespace, jobName string, args map[string]interface{}) (string, error) {
 	var buf bytes.Buffer
 
@@ -90,28 +150,90 @@ func redisKeyUniqueJob(namespace, jobName string, args map[string]interface{}) (
 	return buf.String(), nil
 }
 
+func redisKeyDebounce(namespace, jobName, key string) string {
+	return redisNamespacePrefix(namespace) + "debounce:" + jobName + ":" + key
+}
+
+func redisKeyThrottle(namespace, jobName, key string) string {
+	return redisNamespacePrefix(namespace) + "throttle:" + jobName + ":" + key
+}
+
+func redisKeyStats(namespace string) string {
+	return redisNamespacePrefix(namespace) + "stats"
+}
+
+func redisKeyPeriodicSchedules(namespace string) string {
+	return redisNamespacePrefix(namespace) + "periodic_schedules"
+}
+
+func redisKeyPeriodicFireTimes(namespace string) string {
+	return redisNamespacePrefix(namespace) + "periodic_fire_times"
+}
+
 func redisKeyLastPeriodicEnqueue(namespace string) string {
 	return redisNamespacePrefix(namespace) + "last_periodic_enqueue"
 }
 
+// Pushes a job onto its job queue, onto its group's queue if it has a group (see EnqueueForGroup), onto its named queue
+// if it has one (see EnqueueTo), or onto its lane's queue if it has a lane the job type knows about (see
+// EnqueueToLane). A group is in the job queue's list of groups, eg "work:jobs:emails:groups", as long as its queue
+// isn't empty. Named queues are added to the job queue's set of queues, eg "work:jobs:emails:queues".
+//
+// The keys of the group, lane and named queues aren't passed in KEYS: they're derived from the job queue's key the same
+// way redisKeyJobsGroup, redisKeyJobsLane and redisKeyJobsQueue derive them, since the scripts that requeue jobs only
+// learn a job's group, lane and queue once they decode it, just like they only learn its job queue then (see the jobs
+// prefix they're passed). Like those scripts, this doesn't work on Redis Cluster. TestPushJobKeys keeps the two in sync.
+var redisLuaPushJob = `
+local function pushJob(jobQueue, group, lane, queue, job)
+  if type(group) == 'string' and group ~= '' then
+    if redis.call('lpush', jobQueue .. ':groups:' .. group, job) == 1 then
+      redis.call('lpush', jobQueue .. ':groups', group)
+    end
+  elseif type(queue) == 'string' and queue ~= '' then
+    redis.call('sadd', jobQueue .. ':queues', queue)
+    redis.call('lpush', jobQueue .. ':queues:' .. queue, job)
+  elseif type(lane) == 'string' and lane ~= '' and redis.call('hexists', jobQueue .. ':lanes', lane) == 1 then
+    redis.call('lpush', jobQueue .. ':lanes:' .. lane, job)
+  else
+    redis.call('lpush', jobQueue, job)
+  end
+end
+`
+
+// Releases the group lock of a job. Counters that drop to 0 are removed so the hashes don't grow with every group.
+var redisLuaReleaseGroupLock = `
+local function releaseGroupLock(groupLockKey, groupLockInfoKey, workerPoolID, group)
+  if redis.call('hincrby', groupLockKey, group, -1) <= 0 then
+    redis.call('hdel', groupLockKey, group)
+  end
+  local field = workerPoolID .. ':' .. group
+  if redis.call('hincrby', groupLockInfoKey, field, -1) <= 0 then
+    redis.call('hdel', groupLockInfoKey, field)
+  end
+end
+`
+
 // Used to fetch the next job to run
 //
 // KEYS[1] = the 1st job queue we want to try, eg, "work:jobs:emails"
 // KEYS[2] = the 1st job queue's in prog queue, eg, "work:jobs:emails:97c84119d13cb54119a38743:inprogress"
-// KEYS[3] = the 2nd job queue...
-// KEYS[4] = the 2nd job queue's in prog queue...
+// KEYS[3-7] = the 1st job queue's paused key, lock, lock info, max concurrency and rate limit
+// KEYS[8] = the 1st job queue's list of groups, eg, "work:jobs:emails:groups". The queue of each group is derived from
+// it inside the script, see redisLuaPushJob.
+// KEYS[9-11] = the 1st job queue's group lock, group lock info and max group concurrency
+// KEYS[12] = the 1st job queue's count of groups that had a turn since its jobs without a group last had one
+// KEYS[13] = the 2nd job queue...
 // ...
-// KEYS[N] = the last job queue...
-// KEYS[N+1] = the last job queue's in prog queue...
 // ARGV[1] = job queue's workerPoolID
+// ARGV[2] = current time in epoch milliseconds, used to refill the token buckets of rate limited job queues
 var redisLuaFetchJob = fmt.Sprintf(`
 local function acquireLock(lockKey, lockInfoKey, workerPoolID)
   redis.call('incr', lockKey)
   redis.call('hincrby', lockInfoKey, workerPoolID, 1)
 end
 
-local function haveJobs(jobQueue)
-  return redis.call('llen', jobQueue) > 0
+local function haveJobs(jobQueue, groupsKey)
+  return redis.call('llen', jobQueue) > 0 or redis.call('llen', groupsKey) > 0
 end
 
 local function isPaused(pauseKey)
@@ -131,9 +253,51 @@ local function canRun(lockKey, maxConcurrency)
   end
 end
 
-local res, jobQueue, inProgQueue, pauseKey, lockKey, maxConcurrency, workerPoolID, concurrencyKey, lockInfoKey
+-- takes a job from the next group in line that's below its max concurrency. The groups take turns, so a group
+-- with lots of jobs doesn't hold up the others.
+local function fetchFromGroups(groupsKey, inProgQueue, groupLockKey, groupLockInfoKey, maxGroupConcurrency, workerPoolID)
+  for _=1,redis.call('llen', groupsKey) do
+    local group = redis.call('rpoplpush', groupsKey, groupsKey)
+    local groupQueue = groupsKey .. ':' .. group
+    if redis.call('llen', groupQueue) == 0 then
+      redis.call('lrem', groupsKey, 0, group)
+    else
+      local activeJobs = tonumber(redis.call('hget', groupLockKey, group)) or 0
+      if not maxGroupConcurrency or maxGroupConcurrency == 0 or activeJobs < maxGroupConcurrency then
+        redis.call('hincrby', groupLockKey, group, 1)
+        redis.call('hincrby', groupLockInfoKey, workerPoolID .. ':' .. group, 1)
+        local res = redis.call('rpoplpush', groupQueue, inProgQueue)
+        if redis.call('llen', groupQueue) == 0 then
+          redis.call('lrem', groupsKey, 0, group)
+        end
+        return res
+      end
+    end
+  end
+  return nil
+end
+
+-- refills the token bucket of a rate limited job queue. Returns nil if the queue isn't rate limited.
+local function rateLimitTokens(rateLimitKey, now)
+  local v = redis.call('hmget', rateLimitKey, 'limit', 'per', 'burst', 'tokens', 'ts')
+  local limit, per, burst = tonumber(v[1]), tonumber(v[2]), tonumber(v[3])
+  if not limit or limit == 0 or not per or per == 0 then
+    return nil
+  end
+  local tokens = tonumber(v[4]) or burst
+  local ts = tonumber(v[5]) or now
+  if now > ts then
+    tokens = tokens + (now - ts) * limit / per
+    ts = now
+  end
+  return math.min(burst, tokens), ts
+end
+
+local res, jobQueue, inProgQueue, pauseKey, lockKey, maxConcurrency, workerPoolID, concurrencyKey, lockInfoKey, rateLimitKey, tokens, ts
+local groupsKey, groupLockKey, groupLockInfoKey, groupConcurrencyKey, groupTurnsKey, groupCount, ungroupedTurn
 local keylen = #KEYS
 workerPoolID = ARGV[1]
+local now = tonumber(ARGV[2])
 
 for i=1,keylen,%d do
   jobQueue = KEYS[i]
@@ -142,17 +306,84 @@ for i=1,keylen,%d do
   lockKey = KEYS[i+3]
   lockInfoKey = KEYS[i+4]
   concurrencyKey = KEYS[i+5]
+  rateLimitKey = KEYS[i+6]
+  groupsKey = KEYS[i+7]
+  groupLockKey = KEYS[i+8]
+  groupLockInfoKey = KEYS[i+9]
+  groupConcurrencyKey = KEYS[i+10]
+  groupTurnsKey = KEYS[i+11]
 
   maxConcurrency = tonumber(redis.call('get', concurrencyKey))
 
-  if haveJobs(jobQueue) and not isPaused(pauseKey) and canRun(lockKey, maxConcurrency) then
-    acquireLock(lockKey, lockInfoKey, workerPoolID)
-    res = redis.call('rpoplpush', jobQueue, inProgQueue)
-    return {res, jobQueue, inProgQueue}
+  if haveJobs(jobQueue, groupsKey) and not isPaused(pauseKey) and canRun(lockKey, maxConcurrency) then
+    tokens, ts = rateLimitTokens(rateLimitKey, now)
+    if not tokens or tokens >= 1 then
+      -- jobs without a group take a turn once every group has had one, so neither side holds up the other
+      groupCount = redis.call('llen', groupsKey)
+      ungroupedTurn = groupCount == 0 or (tonumber(redis.call('get', groupTurnsKey)) or 0) >= groupCount
+      res = nil
+      if ungroupedTurn then
+        res = redis.call('rpoplpush', jobQueue, inProgQueue)
+      end
+      if res then
+        redis.call('del', groupTurnsKey)
+      else
+        res = fetchFromGroups(groupsKey, inProgQueue, groupLockKey, groupLockInfoKey, tonumber(redis.call('get', groupConcurrencyKey)), workerPoolID)
+        if res then
+          redis.call('incr', groupTurnsKey)
+        elseif not ungroupedTurn then
+          res = redis.call('rpoplpush', jobQueue, inProgQueue)
+          if res then
+            redis.call('del', groupTurnsKey)
+          end
+        end
+      end
+      if res then
+        if tokens then
+          redis.call('hmset', rateLimitKey, 'tokens', tokens - 1, 'ts', ts)
+        end
+        acquireLock(lockKey, lockInfoKey, workerPoolID)
+        return {res, jobQueue, inProgQueue}
+      end
+    end
   end
 end
 return nil`, fetchKeysPerJobType)
 
+// Used to release the group lock of a job once it's done
+//
+// KEYS[1] = the job's group lock hash, eg "work:jobs:emails:group_lock"
+// KEYS[2] = the job's group lock info hash, eg "work:jobs:emails:group_lock_info"
+// ARGV[1] = workerPoolID
+// ARGV[2] = the job's group
+var redisLuaReleaseGroupLockCmd = redisLuaReleaseGroupLock + `
+releaseGroupLock(KEYS[1], KEYS[2], ARGV[1], ARGV[2])
+return nil
+`
+
+// Used to extend a no-overlap lock while its job is running
+//
+// KEYS[1] = the lock, eg "work:jobs:emails:no_overlap"
+// ARGV[1] = the token of the lock holder
+// ARGV[2] = the lease in milliseconds
+var redisLuaRenewLockCmd = `
+if redis.call('get', KEYS[1]) == ARGV[1] then
+  return redis.call('pexpire', KEYS[1], ARGV[2])
+end
+return 0
+`
+
+// Used to release a no-overlap lock once its job is done
+//
+// KEYS[1] = the lock, eg "work:jobs:emails:no_overlap"
+// ARGV[1] = the token of the lock holder
+var redisLuaReleaseLockCmd = `
+if redis.call('get', KEYS[1]) == ARGV[1] then
+  return redis.call('del', KEYS[1])
+end
+return 0
+`
+
 // Used by the reaper to re-enqueue jobs that were in progress
 //
 // KEYS[1] = the 1st job's in progress queue
@@ -162,15 +393,16 @@ return nil`, fetchKeysPerJobType)
 // ...
 // KEYS[N] = the last job's in progress queue
 // KEYS[N+1] = the last job's job queue
+// (each job also has its lock, lock info, group lock and group lock info keys)
 // ARGV[1] = workerPoolID for job queue
-var redisLuaReenqueueJob = fmt.Sprintf(`
+var redisLuaReenqueueJob = redisLuaPushJob + redisLuaReleaseGroupLock + fmt.Sprintf(`
 local function releaseLock(lockKey, lockInfoKey, workerPoolID)
   redis.call('decr', lockKey)
   redis.call('hincrby', lockInfoKey, workerPoolID, -1)
 end
 
 local keylen = #KEYS
-local res, jobQueue, inProgQueue, workerPoolID, lockKey, lockInfoKey
+local res, j, ok, group, lane, namedQueue, jobQueue, inProgQueue, workerPoolID, lockKey, lockInfoKey, groupLockKey, groupLockInfoKey
 workerPoolID = ARGV[1]
 
 for i=1,keylen,%d do
@@ -178,9 +410,19 @@ for i=1,keylen,%d do
   jobQueue = KEYS[i+1]
   lockKey = KEYS[i+2]
   lockInfoKey = KEYS[i+3]
-  res = redis.call('rpoplpush', inProgQueue, jobQueue)
+  groupLockKey = KEYS[i+4]
+  groupLockInfoKey = KEYS[i+5]
+  res = redis.call('rpop', inProgQueue)
   if res then
+    ok, j = pcall(cjson.decode, res)
+    group = ok and type(j) == 'table' and j['group']
+    lane = ok and type(j) == 'table' and j['lane']
+    namedQueue = ok and type(j) == 'table' and j['queue']
+    pushJob(jobQueue, group, lane, namedQueue, res)
     releaseLock(lockKey, lockInfoKey, workerPoolID)
+    if type(group) == 'string' and group ~= '' then
+      releaseGroupLock(groupLockKey, groupLockInfoKey, workerPoolID, group)
+    end
     return {res, inProgQueue, jobQueue}
   end
 end
@@ -190,20 +432,38 @@ return nil`, requeueKeysPerJob)
 //
 // KEYS[1] = the 1st job's lock
 // KEYS[2] = the 1st job's lock info hash
-// KEYS[3] = the 2nd job's lock
-// KEYS[4] = the 2nd job's lock info hash
+// KEYS[3] = the 1st job's group lock hash
+// KEYS[4] = the 1st job's group lock info hash
+// KEYS[5] = the 2nd job's lock
 // ...
 // KEYS[N] = the last job's lock
 // KEYS[N+1] = the last job's lock info haash
+// KEYS[N+2] = the last job's group lock hash
+// KEYS[N+3] = the last job's group lock info hash
 // ARGV[1] = the dead worker pool id
-var redisLuaReapStaleLocks = `
+var redisLuaReapStaleLocks = fmt.Sprintf(`
 local keylen = #KEYS
-local lock, lockInfo, deadLockCount
+local lock, lockInfo, deadLockCount, groupLock, groupLockInfo, groupLockInfos, group
 local deadPoolID = ARGV[1]
+local deadPoolPrefix = deadPoolID .. ':'
 
-for i=1,keylen,2 do
+for i=1,keylen,%d do
   lock = KEYS[i]
   lockInfo = KEYS[i+1]
+  groupLock = KEYS[i+2]
+  groupLockInfo = KEYS[i+3]
+
+  groupLockInfos = redis.call('hgetall', groupLockInfo)
+  for k=1,#groupLockInfos,2 do
+    if string.sub(groupLockInfos[k], 1, #deadPoolPrefix) == deadPoolPrefix then
+      group = string.sub(groupLockInfos[k], #deadPoolPrefix + 1)
+      if redis.call('hincrby', groupLock, group, -tonumber(groupLockInfos[k+1])) <= 0 then
+        redis.call('hdel', groupLock, group)
+      end
+      redis.call('hdel', groupLockInfo, groupLockInfos[k])
+    end
+  end
+
   deadLockCount = tonumber(redis.call('hget', lockInfo, deadPoolID))
 
   if deadLockCount then
@@ -216,14 +476,14 @@ for i=1,keylen,2 do
   end
 end
 return nil
-`
+`, reapKeysPerJob)
 
 // KEYS[1] = zset of jobs (retry or scheduled), eg work:retry
 // KEYS[2] = zset of dead, eg work:dead. If we don't know the jobName of a job, we'll put it in dead.
 // KEYS[3...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
-var redisLuaZremLpushCmd = `
+var redisLuaZremLpushCmd = redisLuaPushJob + `
 local res, j, queue
 res = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, 1)
 if #res > 0 then
@@ -233,7 +493,7 @@ if #res > 0 then
   for _,v in pairs(KEYS) do
     if v == queue then
       j['t'] = tonumber(ARGV[2])
-      redis.call('lpush', queue, cjson.encode(j))
+      pushJob(queue, j['group'], j['lane'], j['queue'], cjson.encode(j))
       return 'ok'
     end
   end
@@ -268,6 +528,63 @@ end
 return {deletedCount, jobBytes}
 `
 
+// KEYS[1] = job queue, eg, work:jobs:send_email, or the queue of one of its groups, lanes or named queues
+// KEYS[2] = the job queue's list of groups, eg, work:jobs:send_email:groups
+// ARGV[1] = the job to delete, exactly as it's stored in the queue
+// ARGV[2] = the group, if KEYS[1] is the queue of one, or an empty string
+// Returns: number of jobs deleted (typically 1 or 0)
+var redisLuaDeleteQueuedCmd = `
+local deletedCount = redis.call('lrem', KEYS[1], 1, ARGV[1])
+if deletedCount > 0 then
+  local j = cjson.decode(ARGV[1])
+  if j['unique_key'] then
+    redis.call('del', j['unique_key'])
+  end
+end
+if ARGV[2] ~= '' and redis.call('llen', KEYS[1]) == 0 then
+  redis.call('lrem', KEYS[2], 0, ARGV[2])
+end
+return deletedCount
+`
+
+// KEYS[1] = job queue, eg, work:jobs:send_email, or the queue of one of its groups
+// KEYS[2] = the job queue's list of groups, eg, work:jobs:send_email:groups
+// ARGV[1] = max number of jobs to delete
+// ARGV[2] = the group, if KEYS[1] is the queue of one, or an empty string
+// Returns: number of jobs deleted
+var redisLuaPurgeQueueCmd = `
+local jobs, i, j
+jobs = redis.call('lrange', KEYS[1], -tonumber(ARGV[1]), -1)
+local jobCount = #jobs
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  if j['unique_key'] then
+    redis.call('del', j['unique_key'])
+  end
+end
+if jobCount > 0 then
+  redis.call('ltrim', KEYS[1], 0, -jobCount - 1)
+end
+if ARGV[2] ~= '' and redis.call('llen', KEYS[1]) == 0 then
+  redis.call('lrem', KEYS[2], 0, ARGV[2])
+end
+return jobCount
+`
+
+// KEYS[1] = the job type's lanes, eg, work:jobs:send_email:lanes
+// KEYS[2] = the lane's queue, eg, work:jobs:send_email:lanes:high
+// KEYS[3] = job queue, eg, work:jobs:send_email
+// ARGV[1] = the lane
+// Returns: number of jobs moved from the lane to the job queue
+var redisLuaDeleteLaneCmd = `
+redis.call('hdel', KEYS[1], ARGV[1])
+local movedCount = 0
+while redis.call('rpoplpush', KEYS[2], KEYS[3]) do
+  movedCount = movedCount + 1
+end
+return movedCount
+`
+
 // KEYS[1] = zset of dead jobs, eg, work:dead
 // KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
@@ -275,7 +592,7 @@ return {deletedCount, jobBytes}
 // ARGV[3] = died at. The z rank of the job.
 // ARGV[4] = job ID to requeue
 // Returns: number of jobs requeued (typically 1 or 0)
-var redisLuaRequeueSingleDeadCmd = `
+var redisLuaRequeueSingleDeadCmd = redisLuaPushJob + `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[3])
 local jobCount = #jobs
@@ -292,7 +609,7 @@ for i=1,jobCount do
         j['fails'] = nil
         j['failed_at'] = nil
         j['err'] = nil
-        redis.call('lpush', queue, cjson.encode(j))
+        pushJob(queue, j['group'], j['lane'], j['queue'], cjson.encode(j))
         requeuedCount = requeuedCount + 1
         found = true
         break
@@ -308,13 +625,76 @@ end
 return requeuedCount
 `
 
+// KEYS[1] = zset of (retry|scheduled), eg, work:retry
+// KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = retry at or run at. The z rank of the job.
+// ARGV[4] = job ID to enqueue
+// Returns: number of jobs enqueued (typically 1 or 0). Jobs we don't know a queue for are left where they are.
+var redisLuaEnqueueSingleZsetCmd = redisLuaPushJob + `
+local jobs, i, j, queue, enqueuedCount
+jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[3])
+local jobCount = #jobs
+enqueuedCount = 0
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  if j['id'] == ARGV[4] then
+    queue = ARGV[1] .. j['name']
+    for _,v in pairs(KEYS) do
+      if v == queue then
+        redis.call('zrem', KEYS[1], jobs[i])
+        j['t'] = tonumber(ARGV[2])
+        pushJob(queue, j['group'], j['lane'], j['queue'], cjson.encode(j))
+        enqueuedCount = enqueuedCount + 1
+        break
+      end
+    end
+  end
+end
+return enqueuedCount
+`
+
+// KEYS[1] = zset of (retry|scheduled), eg, work:retry
+// KEYS[2] = zset of dead jobs, eg work:dead. If we don't know the jobName of a job, we'll put it in dead.
+// KEYS[3...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = max number of jobs to enqueue
+// Returns: number of jobs taken off the zset
+var redisLuaEnqueueAllZsetCmd = redisLuaPushJob + `
+local jobs, i, j, queue, found
+jobs = redis.call('zrange', KEYS[1], 0, tonumber(ARGV[3]) - 1)
+local jobCount = #jobs
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  redis.call('zrem', KEYS[1], jobs[i])
+  queue = ARGV[1] .. j['name']
+  found = false
+  for _,v in pairs(KEYS) do
+    if v == queue then
+      j['t'] = tonumber(ARGV[2])
+      pushJob(queue, j['group'], j['lane'], j['queue'], cjson.encode(j))
+      found = true
+      break
+    end
+  end
+  if not found then
+    j['err'] = 'unknown job when requeueing'
+    j['failed_at'] = tonumber(ARGV[2])
+    redis.call('zadd', KEYS[2], ARGV[2], cjson.encode(j))
+  end
+end
+return jobCount
+`
+
 // KEYS[1] = zset of dead jobs, eg work:dead
 // KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
 // ARGV[3] = max number of jobs to requeue
 // Returns: number of jobs requeued
-var redisLuaRequeueAllDeadCmd = `
+var redisLuaRequeueAllDeadCmd = redisLuaPushJob + `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, ARGV[3])
 local jobCount = #jobs
@@ -330,7 +710,7 @@ for i=1,jobCount do
       j['fails'] = nil
       j['failed_at'] = nil
       j['err'] = nil
-      redis.call('lpush', queue, cjson.encode(j))
+      pushJob(queue, j['group'], j['lane'], j['queue'], cjson.encode(j))
       requeuedCount = requeuedCount + 1
       found = true
       break
@@ -345,31 +725,184 @@ end
 return requeuedCount
 `
 
+// KEYS[1] = zset of dead jobs, eg work:dead
+// KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// ARGV[2] = current time in epoch seconds
+// ARGV[3...] = the dead jobs to requeue, exactly as they're stored in the zset
+// Returns: number of jobs requeued
+var redisLuaRequeueDeadMembersCmd = redisLuaPushJob + `
+local i, j, queue, found, requeuedCount
+local keylen = #KEYS
+requeuedCount = 0
+for i=3,#ARGV do
+  if redis.call('zrem', KEYS[1], ARGV[i]) > 0 then
+    j = cjson.decode(ARGV[i])
+    queue = ARGV[1] .. j['name']
+    found = false
+    for k=2,keylen do
+      if KEYS[k] == queue then
+        j['t'] = tonumber(ARGV[2])
+        j['fails'] = nil
+        j['failed_at'] = nil
+        j['err'] = nil
+        pushJob(queue, j['group'], j['lane'], j['queue'], cjson.encode(j))
+        requeuedCount = requeuedCount + 1
+        found = true
+        break
+      end
+    end
+    if not found then
+      j['err'] = 'unknown job when requeueing'
+      j['failed_at'] = tonumber(ARGV[2])
+      redis.call('zadd', KEYS[1], ARGV[2] + 5, cjson.encode(j))
+    end
+  end
+end
+return requeuedCount
+`
+
+// Used to enforce the dead job retention policy
+//
+// KEYS[1] = zset of dead jobs, eg work:dead
+// KEYS[2] = stats hash, eg work:stats. The number of trimmed jobs is added to its dead_jobs_trimmed field.
+// ARGV[1] = drop jobs that died before this epoch second (0 to keep them regardless of age)
+// ARGV[2] = max number of dead jobs to keep (0 for no max)
+// Returns: number of jobs trimmed
+var redisLuaTrimDeadCmd = `
+local trimmed = 0
+local keepSince = tonumber(ARGV[1])
+local maxCount = tonumber(ARGV[2])
+if keepSince > 0 then
+  trimmed = trimmed + redis.call('zremrangebyscore', KEYS[1], '-inf', '(' .. keepSince)
+end
+if maxCount > 0 then
+  trimmed = trimmed + redis.call('zremrangebyrank', KEYS[1], 0, -maxCount - 1)
+end
+if trimmed > 0 then
+  redis.call('hincrby', KEYS[2], 'dead_jobs_trimmed', trimmed)
+end
+return trimmed
+`
+
+// Resolves the unique mode and TTL of a job, falling back to the job type's options written by the worker pool
+//
+// KEYS[3] = the job type's unique options hash, eg "work:jobs:emails:unique"
+// ARGV[3] = unique mode, or an empty string for the job type's
+// ARGV[4] = unique TTL in seconds, or 0 for the job type's
+var redisLuaUniqueOptions = `
+local mode = ARGV[3]
+if mode == '' then
+  mode = redis.call('hget', KEYS[3], 'mode') or ''
+end
+local ttl = tonumber(ARGV[4])
+if ttl <= 0 then
+  ttl = tonumber(redis.call('hget', KEYS[3], 'ttl')) or 86400
+end
+if ttl <= 0 then
+  ttl = 86400
+end
+`
+
 // KEYS[1] = job queue to push onto
 // KEYS[2] = Unique job's key. Test for existence and set if we push.
+// KEYS[3] = the job type's unique options hash
 // ARGV[1] = job
 // ARGV[2] = updated job or just a 1 if arguments don't update
-var redisLuaEnqueueUnique = `
-if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', '86400') then
+// ARGV[3] = unique mode, or an empty string for the job type's
+// ARGV[4] = unique TTL in seconds, or 0 for the job type's
+var redisLuaEnqueueUnique = redisLuaUniqueOptions + `
+if mode == 'while_executing' then
+  redis.call('lpush', KEYS[1], ARGV[1])
+  return 'ok'
+end
+if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', ttl) then
   redis.call('lpush', KEYS[1], ARGV[1])
   return 'ok'
 else
-  redis.call('set', KEYS[2], ARGV[2], 'EX', '86400')
+  redis.call('set', KEYS[2], ARGV[2], 'EX', ttl)
 end
 return 'dup'
 `
 
 // KEYS[1] = scheduled job queue
 // KEYS[2] = Unique job's key. Test for existence and set if we push.
+// KEYS[3] = the job type's unique options hash
 // ARGV[1] = job
 // ARGV[2] = updated job or just a 1 if arguments don't update
-// ARGV[3] = epoch seconds for job to be run at
-var redisLuaEnqueueUniqueIn = `
-if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', '86400') then
-  redis.call('zadd', KEYS[1], ARGV[3], ARGV[1])
+// ARGV[3] = unique mode, or an empty string for the job type's
+// ARGV[4] = unique TTL in seconds, or 0 for the job type's
+// ARGV[5] = epoch seconds for job to be run at
+var redisLuaEnqueueUniqueIn = redisLuaUniqueOptions + `
+if mode == 'while_executing' then
+  redis.call('zadd', KEYS[1], ARGV[5], ARGV[1])
+  return 'ok'
+end
+if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', ttl) then
+  redis.call('zadd', KEYS[1], ARGV[5], ARGV[1])
   return 'ok'
 else
-  redis.call('set', KEYS[2], ARGV[2], 'EX', '86400')
+  redis.call('set', KEYS[2], ARGV[2], 'EX', ttl)
 end
 return 'dup'
 `
+
+// Used by EnqueueForGroup, EnqueueTo and EnqueueToLane, and to hand back jobs that are still running when
+// StopWithContext gives up
+//
+// KEYS[1] = the job queue, eg "work:jobs:emails"
+// ARGV[1] = job
+// ARGV[2] = the job's group
+// ARGV[3] = the job's lane
+// ARGV[4] = the job's named queue
+var redisLuaEnqueuePushJob = redisLuaPushJob + `
+pushJob(KEYS[1], ARGV[2], ARGV[3], ARGV[4], ARGV[1])
+return 'ok'
+`
+
+// Used by EnqueueDebounced to replace the pending job, if any, with one that runs later.
+//
+// KEYS[1] = scheduled job queue
+// KEYS[2] = debounce key, holds the pending job
+// ARGV[1] = job payload
+// ARGV[2] = epoch seconds for job to be run at
+// ARGV[3] = TTL of the debounce key in seconds
+var redisLuaEnqueueDebounced = `
+local pending = redis.call('get', KEYS[2])
+if pending then
+  redis.call('zrem', KEYS[1], pending)
+end
+redis.call('zadd', KEYS[1], ARGV[2], ARGV[1])
+redis.call('set', KEYS[2], ARGV[1], 'EX', ARGV[3])
+return 'ok'
+`
+
+// Used by EnqueueThrottled. Runs the job now if nothing ran in the last window. Otherwise the job becomes the trailing
+// run at the end of the window, replacing the trailing run if there already is one. Returns when the job runs.
+//
+// KEYS[1] = scheduled job queue
+// KEYS[2] = throttle key, a hash with the time of the last run and its payload
+// ARGV[1] = job payload
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = window in seconds
+var redisLuaEnqueueThrottled = `
+local now = tonumber(ARGV[2])
+local window = tonumber(ARGV[3])
+local last = tonumber(redis.call('hget', KEYS[2], 'last'))
+local runAt = now
+
+if last and now < last then
+  runAt = last
+  local trailing = redis.call('hget', KEYS[2], 'job')
+  if trailing then
+    redis.call('zrem', KEYS[1], trailing)
+  end
+elseif last and now < last + window then
+  runAt = last + window
+end
+
+redis.call('zadd', KEYS[1], runAt, ARGV[1])
+redis.call('hmset', KEYS[2], 'last', runAt, 'job', ARGV[1])
+redis.call('expire', KEYS[2], runAt + window - now + 1)
+return runAt
+`
@@ -2,15 +2,18 @@ package work
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
 
 type requeuer struct {
-	namespace string
-	pool      *redis.Pool
+	namespace  string
+	pool       *redis.Pool
+	requeueKey string
 
+	mtx                sync.Mutex // guards the script and args, which change with the job names
 	redisRequeueScript *redis.Script
 	redisRequeueArgs   []interface{}
 
@@ -22,21 +25,10 @@ type requeuer struct {
 }
 
 func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames []string) *requeuer {
-	args := make([]interface{}, 0, len(jobNames)+2+2)
-	args = append(args, requeueKey)              // KEY[1]
-	args = append(args, redisKeyDead(namespace)) // KEY[2]
-	for _, jobName := range jobNames {
-		args = append(args, redisKeyJobs(namespace, jobName)) // KEY[3, 4, ...]
-	}
-	args = append(args, redisKeyJobsPrefix(namespace)) // ARGV[1]
-	args = append(args, 0)                             // ARGV[2] -- NOTE: We're going to change this one on every call
-
-	return &requeuer{
-		namespace: namespace,
-		pool:      pool,
-
-		redisRequeueScript: redis.NewScript(len(jobNames)+2, redisLuaZremLpushCmd),
-		redisRequeueArgs:   args,
+	r := &requeuer{
+		namespace:  namespace,
+		pool:       pool,
+		requeueKey: requeueKey,
 
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
@@ -44,6 +36,25 @@ func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames
 		drainChan:        make(chan struct{}),
 		doneDrainingChan: make(chan struct{}),
 	}
+	r.setJobNames(jobNames)
+	return r
+}
+
+// setJobNames changes the jobs that are requeued. Jobs of other types are moved to the dead queue.
+func (r *requeuer) setJobNames(jobNames []string) {
+	args := make([]interface{}, 0, len(jobNames)+2+2)
+	args = append(args, r.requeueKey)              // KEY[1]
+	args = append(args, redisKeyDead(r.namespace)) // KEY[2]
+	for _, jobName := range jobNames {
+		args = append(args, redisKeyJobs(r.namespace, jobName)) // KEY[3, 4, ...]
+	}
+	args = append(args, redisKeyJobsPrefix(r.namespace)) // ARGV[1]
+	args = append(args, 0)                               // ARGV[2] -- NOTE: We're going to change this one on every call
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.redisRequeueScript = redis.NewScript(len(jobNames)+2, redisLuaZremLpushCmd)
+	r.redisRequeueArgs = args
 }
 
 func (r *requeuer) start() {
@@ -87,9 +98,10 @@ func (r *requeuer) process() bool {
 	conn := r.pool.Get()
 	defer conn.Close()
 
+	r.mtx.Lock()
 	r.redisRequeueArgs[len(r.redisRequeueArgs)-1] = nowEpochSeconds()
-
 	res, err := redis.String(r.redisRequeueScript.Do(conn, r.redisRequeueArgs...))
+	r.mtx.Unlock()
 	if err == redis.ErrNil {
 		return false
 	} else if err != nil {
@@ -11,6 +11,13 @@ func nowEpochSeconds() int64 {
 	return time.Now().Unix()
 }
 
+func nowEpochMilliseconds() int64 {
+	if nowMock != 0 {
+		return nowMock * 1000
+	}
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
 func setNowEpochSecondsMock(t int64) {
 	nowMock = t
 }
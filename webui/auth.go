@@ -0,0 +1,172 @@
+package webui
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gocraft/web"
+)
+
+// Role decides what a user of the web UI can do. Each role can do everything the roles before it can.
+type Role string
+
+const (
+	// RoleViewer can only look, ie make GET requests.
+	RoleViewer Role = "viewer"
+	// RoleOperator can also retry, delete and run single jobs.
+	RoleOperator Role = "operator"
+	// RoleAdmin can also retry, delete and run jobs in bulk, purge queues, quiet, stop and scale worker pools, and
+	// manage periodic schedules.
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	}
+	return 0
+}
+
+// BasicAuthUser is a user that signs in with HTTP basic auth, see ServerOptions.BasicAuth.
+type BasicAuthUser struct {
+	Password string
+	Role     Role
+}
+
+// BearerToken is a token that clients send in an "Authorization: Bearer <token>" header, see ServerOptions.BearerTokens.
+type BearerToken struct {
+	User string
+	Role Role
+}
+
+// AuthFunc authenticates a request, and returns the name and role of its user. Requests it returns an error for are
+// answered with 401.
+type AuthFunc func(r *http.Request) (user string, role Role, err error)
+
+// ServerOptions can be passed to NewServerWithOptions. If none of the auth options are set, anyone can use the web UI
+// as an admin.
+type ServerOptions struct {
+	BasicAuth    map[string]BasicAuthUser // By user name
+	BearerTokens map[string]BearerToken   // By token
+	Authenticate AuthFunc                 // For requests that don't carry credentials for the options above, eg to check a session cookie
+}
+
+const (
+	csrfCookieName = "work_csrf_token"
+	csrfHeaderName = "X-CSRF-Token"
+)
+
+var (
+	errMissingCredentials = errors.New("missing credentials")
+	errInvalidCredentials = errors.New("invalid credentials")
+	errInvalidCSRFToken   = errors.New("missing or invalid CSRF token")
+)
+
+func (o *ServerOptions) authEnabled() bool {
+	return o.BasicAuth != nil || o.BearerTokens != nil || o.Authenticate != nil
+}
+
+// authenticate returns the user and role of the request, and whether it was authenticated with a bearer token.
+func (o *ServerOptions) authenticate(r *http.Request) (string, Role, bool, error) {
+	if user, password, ok := r.BasicAuth(); ok && o.BasicAuth != nil {
+		u, found := o.BasicAuth[user]
+		if found && subtle.ConstantTimeCompare([]byte(password), []byte(u.Password)) == 1 {
+			return user, u.Role, false, nil
+		}
+		return "", "", false, errInvalidCredentials
+	}
+
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") && o.BearerTokens != nil {
+		token := []byte(strings.TrimPrefix(header, "Bearer "))
+		for t, bt := range o.BearerTokens {
+			if subtle.ConstantTimeCompare(token, []byte(t)) == 1 {
+				return bt.User, bt.Role, true, nil
+			}
+		}
+		return "", "", true, errInvalidCredentials
+	}
+
+	if o.Authenticate != nil {
+		user, role, err := o.Authenticate(r)
+		return user, role, false, err
+	}
+	return "", "", false, errMissingCredentials
+}
+
+// authenticate sets the user and role of the request, and checks its CSRF token, with or without authentication.
+// Requests authenticated with a bearer token don't need one, since browsers don't send bearer tokens on their own.
+func (c *context) authenticate(rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
+	bearer := false
+	if c.options.authEnabled() {
+		user, role, b, err := c.options.authenticate(r.Request)
+		if err != nil {
+			if c.options.BasicAuth != nil {
+				rw.Header().Set("WWW-Authenticate", `Basic realm="gocraft/work", charset="UTF-8"`)
+			}
+			renderErrorStatus(rw, http.StatusUnauthorized, err)
+			return
+		}
+		if role.rank() == 0 {
+			renderErrorStatus(rw, http.StatusForbidden, fmt.Errorf("%s has an unknown role %q", user, role))
+			return
+		}
+		c.user, c.role, bearer = user, role, b
+	} else {
+		c.role = RoleAdmin
+	}
+
+	if !bearer {
+		cookie, err := r.Cookie(csrfCookieName)
+		if r.Method != "GET" && r.Method != "HEAD" {
+			if err != nil || cookie.Value == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(r.Header.Get(csrfHeaderName))) != 1 {
+				renderErrorStatus(rw, http.StatusForbidden, errInvalidCSRFToken)
+				return
+			}
+		} else if err != nil || cookie.Value == "" {
+			if err := setCSRFCookie(rw, r.Request); err != nil {
+				renderError(rw, err)
+				return
+			}
+		}
+	}
+
+	next(rw, r)
+}
+
+// setCSRFCookie sets a new CSRF token, which the web UI reads from the cookie and sends back in a header with every
+// POST. Another site can make the browser send the cookie, but can't read it.
+func setCSRFCookie(rw http.ResponseWriter, r *http.Request) error {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return err
+	}
+	http.SetCookie(rw, &http.Cookie{
+		Name:     csrfCookieName,
+		Value:    hex.EncodeToString(token),
+		Path:     "/",
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+	return nil
+}
+
+// requireRole returns a middleware that answers 403 unless the user has at least the given role.
+func requireRole(role Role) func(*context, web.ResponseWriter, *web.Request, web.NextMiddlewareFunc) {
+	return func(c *context, rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
+		if c.role.rank() < role.rank() {
+			renderErrorStatus(rw, http.StatusForbidden, fmt.Errorf("%s needs the %s role", c.user, role))
+			return
+		}
+		next(rw, r)
+	}
+}
@@ -0,0 +1,170 @@
+package webui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebUIBasicAuth(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	s := NewServerWithOptions(ns, pool, ":6666", ServerOptions{BasicAuth: map[string]BasicAuthUser{
+		"vic":   {Password: "v", Role: RoleViewer},
+		"olga":  {Password: "o", Role: RoleOperator},
+		"alice": {Password: "a", Role: RoleAdmin},
+	}})
+
+	recorder := serveAuth(s, "GET", "/queues", nil)
+	assert.Equal(t, 401, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("WWW-Authenticate"), "Basic")
+
+	recorder = serveAuth(s, "GET", "/queues", func(r *http.Request) { r.SetBasicAuth("vic", "wrong") })
+	assert.Equal(t, 401, recorder.Code)
+	recorder = serveAuth(s, "GET", "/queues", func(r *http.Request) { r.SetBasicAuth("nobody", "v") })
+	assert.Equal(t, 401, recorder.Code)
+
+	// Viewers can look, and get a CSRF token.
+	recorder = serveAuth(s, "GET", "/queues", func(r *http.Request) { r.SetBasicAuth("vic", "v") })
+	assert.Equal(t, 200, recorder.Code)
+	token := csrfCookie(recorder)
+	assert.Len(t, token, 64)
+
+	// Each role can do what the ones before it can. The job to delete doesn't exist, which only matters once the user
+	// is allowed to try.
+	cases := []struct {
+		user, password, path string
+		forbidden            bool
+	}{
+		{"vic", "v", "/delete_queued_job/wat/123", true},
+		{"vic", "v", "/delete_all_dead_jobs", true},
+		{"olga", "o", "/delete_queued_job/wat/123", false},
+		{"olga", "o", "/delete_all_dead_jobs", true},
+		{"olga", "o", "/purge_queue/wat", true},
+		{"alice", "a", "/delete_queued_job/wat/123", false},
+		{"alice", "a", "/delete_all_dead_jobs", false},
+		{"alice", "a", "/purge_queue/wat", false},
+	}
+	for _, c := range cases {
+		recorder = serveAuth(s, "POST", c.path, func(r *http.Request) {
+			r.SetBasicAuth(c.user, c.password)
+			setCSRFToken(r, token)
+		})
+		assert.Equal(t, c.forbidden, recorder.Code == 403, "%s %s", c.user, c.path)
+	}
+
+	// POSTs need the CSRF token from the cookie in a header.
+	recorder = serveAuth(s, "POST", "/delete_all_dead_jobs", func(r *http.Request) { r.SetBasicAuth("alice", "a") })
+	assert.Equal(t, 403, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "CSRF")
+	recorder = serveAuth(s, "POST", "/delete_all_dead_jobs", func(r *http.Request) {
+		r.SetBasicAuth("alice", "a")
+		r.AddCookie(&http.Cookie{Name: csrfCookieName, Value: token})
+		r.Header.Set(csrfHeaderName, "forged")
+	})
+	assert.Equal(t, 403, recorder.Code)
+}
+
+func TestWebUIBearerAndCustomAuth(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	s := NewServerWithOptions(ns, pool, ":6666", ServerOptions{
+		BearerTokens: map[string]BearerToken{"s3cret": {User: "ci", Role: RoleAdmin}},
+		Authenticate: func(r *http.Request) (string, Role, error) {
+			user := r.Header.Get("X-User")
+			if user == "" {
+				return "", "", fmt.Errorf("no session")
+			}
+			return user, Role(r.Header.Get("X-Role")), nil
+		},
+	})
+
+	recorder := serveAuth(s, "GET", "/queues", nil)
+	assert.Equal(t, 401, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("WWW-Authenticate"))
+	assert.Contains(t, recorder.Body.String(), "no session")
+
+	// Bearer tokens don't need a CSRF token, since browsers don't send them on their own.
+	recorder = serveAuth(s, "POST", "/retry_all_dead_jobs", func(r *http.Request) { r.Header.Set("Authorization", "Bearer s3cret") })
+	assert.Equal(t, 200, recorder.Code)
+	assert.Empty(t, csrfCookie(recorder))
+	recorder = serveAuth(s, "GET", "/queues", func(r *http.Request) { r.Header.Set("Authorization", "Bearer wrong") })
+	assert.Equal(t, 401, recorder.Code)
+
+	recorder = serveAuth(s, "GET", "/queues", func(r *http.Request) {
+		r.Header.Set("X-User", "eve")
+		r.Header.Set("X-Role", "root")
+	})
+	assert.Equal(t, 403, recorder.Code)
+	var res struct {
+		Error string `json:"error"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, `eve has an unknown role "root"`, res.Error)
+
+	recorder = serveAuth(s, "GET", "/queues", func(r *http.Request) {
+		r.Header.Set("X-User", "olga")
+		r.Header.Set("X-Role", "operator")
+	})
+	assert.Equal(t, 200, recorder.Code)
+	token := csrfCookie(recorder)
+	recorder = serveAuth(s, "POST", "/retry_all_dead_jobs", func(r *http.Request) {
+		r.Header.Set("X-User", "olga")
+		r.Header.Set("X-Role", "operator")
+		setCSRFToken(r, token)
+	})
+	assert.Equal(t, 403, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "olga needs the admin role")
+}
+
+func TestWebUICSRFWithoutAuth(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := serveAuth(s, "GET", "/queues", nil)
+	assert.Equal(t, 200, recorder.Code)
+	token := csrfCookie(recorder)
+	assert.Len(t, token, 64)
+
+	// Anyone can do anything, but another site can't make their browser do it.
+	recorder = serveAuth(s, "POST", "/delete_all_dead_jobs", nil)
+	assert.Equal(t, 403, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "CSRF")
+	recorder = serveAuth(s, "POST", "/delete_all_dead_jobs", func(r *http.Request) { setCSRFToken(r, token) })
+	assert.Equal(t, 200, recorder.Code)
+}
+
+func serveAuth(s *Server, method, path string, setup func(r *http.Request)) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest(method, path, nil)
+	if setup != nil {
+		setup(request)
+	}
+	s.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func setCSRFToken(r *http.Request, token string) {
+	r.AddCookie(&http.Cookie{Name: csrfCookieName, Value: token})
+	r.Header.Set(csrfHeaderName, token)
+}
+
+func csrfCookie(recorder *httptest.ResponseRecorder) string {
+	for _, cookie := range (&http.Response{Header: recorder.Header()}).Cookies() {
+		if cookie.Name == csrfCookieName {
+			return cookie.Value
+		}
+	}
+	return ""
+}
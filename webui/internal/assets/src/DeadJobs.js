@@ -1,6 +1,7 @@
 import React from 'react';
 import PropTypes from 'prop-types';
 import PageList from './PageList';
+import JobFilter from './JobFilter';
 import UnixTime from './UnixTime';
 import styles from './bootstrap.min.css';
 import cx from './cx';
@@ -12,9 +13,12 @@ export default class DeadJobs extends React.Component {
     deleteAllURL: PropTypes.string,
     retryURL: PropTypes.string,
     retryAllURL: PropTypes.string,
+    deleteMatchingURL: PropTypes.string,
+    retryMatchingURL: PropTypes.string,
   }
 
   state = {
+    filter: '',
     selected: [],
     page: 1,
     count: 0,
@@ -25,7 +29,7 @@ export default class DeadJobs extends React.Component {
     if (!this.props.fetchURL) {
       return;
     }
-    fetch(`${this.props.fetchURL}?page=${this.state.page}`).
+    fetch(`${this.props.fetchURL}?page=${this.state.page}&${this.state.filter}`).
       then((resp) => resp.json()).
       then((data) => {
         this.setState({
@@ -44,6 +48,10 @@ export default class DeadJobs extends React.Component {
     this.setState({page: page}, this.fetch);
   }
 
+  updateFilter(filter) {
+    this.setState({page: 1, filter: filter}, this.fetch);
+  }
+
   checked(job) {
     return this.state.selected.includes(job);
   }
@@ -119,6 +127,24 @@ export default class DeadJobs extends React.Component {
     });
   }
 
+  deleteMatching() {
+    if (!this.props.deleteMatchingURL) {
+      return;
+    }
+    fetch(`${this.props.deleteMatchingURL}?${this.state.filter}`, {method: 'post'}).then(() => {
+      this.updatePage(1);
+    });
+  }
+
+  retryMatching() {
+    if (!this.props.retryMatchingURL) {
+      return;
+    }
+    fetch(`${this.props.retryMatchingURL}?${this.state.filter}`, {method: 'post'}).then(() => {
+      this.updatePage(1);
+    });
+  }
+
   render() {
     return (
       <div>
@@ -126,6 +152,7 @@ export default class DeadJobs extends React.Component {
           <div className={styles.panelHeading}>Dead Jobs</div>
           <div className={styles.panelBody}>
             <p>{this.state.count} job(s) are dead.</p>
+            <JobFilter onChange={(filter) => this.updateFilter(filter)}/>
             <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
           </div>
           <div className={styles.tableResponsive}>
@@ -160,6 +187,8 @@ export default class DeadJobs extends React.Component {
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.retrySelected()}>Retry Selected Jobs</button>
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.deleteAll()}>Delete All Jobs</button>
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.retryAll()}>Retry All Jobs</button>
+          <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.deleteMatching()}>Delete Matching Jobs</button>
+          <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.retryMatching()}>Retry Matching Jobs</button>
         </div>
       </div>
     );
@@ -0,0 +1,54 @@
+import React from 'react';
+import PropTypes from 'prop-types';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+const fields = [
+  {name: 'job_name', label: 'Job name'},
+  {name: 'error', label: 'Error contains'},
+  {name: 'arg_key', label: 'Arg key'},
+  {name: 'arg_value', label: 'Arg value'},
+];
+
+// JobFilter renders the filter form shared by the retry, scheduled and dead job pages.
+// onChange is called with the query string for the current filter, eg "job_name=foo&error=bar".
+export default class JobFilter extends React.Component {
+  static propTypes = {
+    onChange: PropTypes.func.isRequired,
+  }
+
+  state = {
+    job_name: '',
+    error: '',
+    arg_key: '',
+    arg_value: '',
+  }
+
+  get query() {
+    return fields.
+      filter((f) => this.state[f.name] !== '').
+      map((f) => `${f.name}=${encodeURIComponent(this.state[f.name])}`).
+      join('&');
+  }
+
+  submit(e) {
+    e.preventDefault();
+    this.props.onChange(this.query);
+  }
+
+  render() {
+    return (
+      <form className={styles.formInline} onSubmit={(e) => this.submit(e)}>
+        {
+          fields.map((f) => {
+            return (
+              <input key={f.name} type="text" className={styles.formControl} placeholder={f.label}
+                value={this.state[f.name]} onChange={(e) => this.setState({[f.name]: e.target.value})}/>
+            );
+          })
+        }
+        <button type="submit" className={cx(styles.btn, styles.btnDefault)}>Filter</button>
+      </form>
+    );
+  }
+}
@@ -0,0 +1,19 @@
+import './TestSetup';
+import expect from 'expect';
+import JobFilter from './JobFilter';
+import React from 'react';
+import { mount } from 'enzyme';
+
+describe('JobFilter', () => {
+  it('builds a query', () => {
+    let query = null;
+    let jobFilter = mount(<JobFilter onChange={(q) => { query = q; }} />);
+    expect(jobFilter.instance().query).toEqual('');
+
+    jobFilter.setState({job_name: 'test', error: 'time out'});
+    expect(jobFilter.instance().query).toEqual('job_name=test&error=time%20out');
+
+    jobFilter.find('form').simulate('submit');
+    expect(query).toEqual('job_name=test&error=time%20out');
+  });
+});
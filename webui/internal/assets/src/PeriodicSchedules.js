@@ -0,0 +1,183 @@
+import React from 'react';
+import PropTypes from 'prop-types';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+const emptyForm = {
+  id: '',
+  job_name: '',
+  spec: '',
+  args: '',
+  misfire: 'skip',
+  max_misfires: '',
+};
+
+const misfireLabels = {
+  skip: 'Skip missed runs',
+  run_once: 'Run once',
+  run_all: 'Run all missed',
+};
+
+export default class PeriodicSchedules extends React.Component {
+  static propTypes = {
+    fetchURL: PropTypes.string,
+    addURL: PropTypes.string,
+    updateURL: PropTypes.string,
+    enableURL: PropTypes.string,
+    disableURL: PropTypes.string,
+    deleteURL: PropTypes.string,
+  }
+
+  state = {
+    schedules: [],
+    form: emptyForm,
+    error: '',
+  }
+
+  fetch() {
+    if (!this.props.fetchURL) {
+      return;
+    }
+    fetch(this.props.fetchURL).
+      then((resp) => resp.json()).
+      then((data) => {
+        this.setState({
+          schedules: data || []
+        });
+      });
+  }
+
+  componentWillMount() {
+    this.fetch();
+  }
+
+  post(url) {
+    if (!url) {
+      return;
+    }
+    fetch(url, {method: 'post'}).then(() => this.fetch());
+  }
+
+  edit(schedule) {
+    this.setState({
+      error: '',
+      form: {
+        id: schedule.id,
+        job_name: schedule.job_name,
+        spec: schedule.spec,
+        args: schedule.args ? JSON.stringify(schedule.args) : '',
+        misfire: schedule.misfire || 'skip',
+        max_misfires: schedule.max_misfires ? String(schedule.max_misfires) : '',
+      }
+    });
+  }
+
+  updateForm(name, value) {
+    this.setState({form: Object.assign({}, this.state.form, {[name]: value})});
+  }
+
+  save(e) {
+    e.preventDefault();
+    let form = this.state.form;
+    let url = form.id ? `${this.props.updateURL}/${form.id}` : this.props.addURL;
+    if (!url) {
+      return;
+    }
+    let body = ['job_name', 'spec', 'args', 'misfire', 'max_misfires'].
+      map((name) => `${name}=${encodeURIComponent(form[name])}`).
+      join('&');
+    fetch(url, {
+      method: 'post',
+      headers: {'Content-Type': 'application/x-www-form-urlencoded'},
+      body: body,
+    }).
+      then((resp) => resp.json()).
+      then((data) => {
+        if (data.error) {
+          this.setState({error: data.error});
+          return;
+        }
+        this.setState({form: emptyForm, error: ''}, this.fetch);
+      });
+  }
+
+  render() {
+    let form = this.state.form;
+    return (
+      <div>
+        <div className={cx(styles.panel, styles.panelDefault)}>
+          <div className={styles.panelHeading}>Periodic Schedules</div>
+          <div className={styles.panelBody}>
+            <p>{this.state.schedules.length} schedule(s).</p>
+          </div>
+          <div className={styles.tableResponsive}>
+            <table className={styles.table}>
+              <tbody>
+                <tr>
+                  <th>Job Name</th>
+                  <th>Spec</th>
+                  <th>Arguments</th>
+                  <th>Misfires</th>
+                  <th>Last Fire</th>
+                  <th>Next Fire</th>
+                  <th></th>
+                </tr>
+                {
+                  this.state.schedules.map((schedule) => {
+                    return (
+                      <tr key={schedule.id}>
+                        <td>{schedule.job_name}</td>
+                        <td><code>{schedule.spec}</code></td>
+                        <td>{JSON.stringify(schedule.args || {})}</td>
+                        <td>{misfireLabels[schedule.misfire || 'skip']}{schedule.misfire === 'run_all' && ` (max ${schedule.max_misfires || 100})`}</td>
+                        <td>{schedule.last_fire_at ? <UnixTime ts={schedule.last_fire_at} /> : 'never'}</td>
+                        <td>{schedule.disabled ? 'disabled' : (schedule.next_fire_at ? <UnixTime ts={schedule.next_fire_at} /> : 'never')}</td>
+                        <td>
+                          <div className={styles.btnGroup} role="group">
+                            <button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.edit(schedule)}>Edit</button>
+                            {
+                              schedule.disabled ?
+                                <button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.post(`${this.props.enableURL}/${schedule.id}`)}>Enable</button> :
+                                <button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.post(`${this.props.disableURL}/${schedule.id}`)}>Disable</button>
+                            }
+                            <button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.post(`${this.props.deleteURL}/${schedule.id}`)}>Delete</button>
+                          </div>
+                        </td>
+                      </tr>
+                    );
+                  })
+                }
+              </tbody>
+            </table>
+          </div>
+        </div>
+        <div className={cx(styles.panel, styles.panelDefault)}>
+          <div className={styles.panelHeading}>{form.id ? 'Edit Schedule' : 'Add Schedule'}</div>
+          <div className={styles.panelBody}>
+            {this.state.error && <div className={cx(styles.alert, styles.alertDanger)}>{this.state.error}</div>}
+            <form className={styles.formInline} onSubmit={(e) => this.save(e)}>
+              <input type="text" className={styles.formControl} placeholder="Job name"
+                value={form.job_name} onChange={(e) => this.updateForm('job_name', e.target.value)}/>
+              <input type="text" className={styles.formControl} placeholder="Spec, eg 0 30 9 * * *"
+                value={form.spec} onChange={(e) => this.updateForm('spec', e.target.value)}/>
+              <input type="text" className={styles.formControl} placeholder='Arguments, eg {"region": "eu"}'
+                value={form.args} onChange={(e) => this.updateForm('args', e.target.value)}/>
+              <select className={styles.formControl} value={form.misfire} onChange={(e) => this.updateForm('misfire', e.target.value)}>
+                {
+                  Object.keys(misfireLabels).map((policy) => {
+                    return <option key={policy} value={policy}>{misfireLabels[policy]}</option>;
+                  })
+                }
+              </select>
+              {form.misfire === 'run_all' && <input type="text" className={styles.formControl} placeholder="Max missed runs (100)"
+                value={form.max_misfires} onChange={(e) => this.updateForm('max_misfires', e.target.value)}/>}
+              <button type="submit" className={cx(styles.btn, styles.btnDefault)}>Save</button>
+              {form.id && <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.setState({form: emptyForm, error: ''})}>Cancel</button>}
+            </form>
+          </div>
+        </div>
+      </div>
+    );
+  }
+}
@@ -0,0 +1,39 @@
+import './TestSetup';
+import expect from 'expect';
+import PeriodicSchedules from './PeriodicSchedules';
+import React from 'react';
+import { mount } from 'enzyme';
+
+describe('PeriodicSchedules', () => {
+  it('shows schedules', () => {
+    let periodicSchedules = mount(<PeriodicSchedules />);
+
+    expect(periodicSchedules.state().schedules.length).toEqual(0);
+
+    periodicSchedules.setState({
+      schedules: [
+        {id: '1', job_name: 'report', spec: '0 0 9 * * *', args: {region: 'eu'}, last_fire_at: 1467760821, next_fire_at: 1467847221},
+        {id: '2', job_name: 'cleanup', spec: '@every 1h', disabled: true}
+      ]
+    });
+
+    expect(periodicSchedules.state().schedules.length).toEqual(2);
+    expect(periodicSchedules.find('tr').length).toEqual(3);
+  });
+
+  it('edits schedules', () => {
+    let periodicSchedules = mount(<PeriodicSchedules />);
+    let schedule = {id: '1', job_name: 'report', spec: '0 0 9 * * *', args: {region: 'eu'}, misfire: 'run_all', max_misfires: 5};
+    periodicSchedules.setState({schedules: [schedule]});
+
+    periodicSchedules.instance().edit(schedule);
+    expect(periodicSchedules.state().form.id).toEqual('1');
+    expect(periodicSchedules.state().form.args).toEqual('{"region":"eu"}');
+    expect(periodicSchedules.state().form.misfire).toEqual('run_all');
+    expect(periodicSchedules.state().form.max_misfires).toEqual('5');
+
+    periodicSchedules.instance().updateForm('spec', '0 0 10 * * *');
+    expect(periodicSchedules.state().form.spec).toEqual('0 0 10 * * *');
+    expect(periodicSchedules.state().form.job_name).toEqual('report');
+  });
+});
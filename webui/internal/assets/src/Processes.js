@@ -42,15 +42,32 @@ class BusyWorkers extends React.Component {
   }
 }
 
+// poolMatchesLabels returns true if the pool has all the labels in filter, which is a space separated list of
+// key=value terms, eg "version=1.4.2 region=eu-west-1". A term without "=" matches any label key or value.
+export function poolMatchesLabels(pool, filter) {
+  const labels = pool.labels || {};
+  return filter.split(/\s+/).filter((term) => term !== '').every((term) => {
+    const i = term.indexOf('=');
+    if (i < 0) {
+      return Object.keys(labels).some((k) => k === term || labels[k] === term);
+    }
+    return labels[term.slice(0, i)] === term.slice(i + 1);
+  });
+}
+
 export default class Processes extends React.Component {
   static propTypes = {
     busyWorkerURL: PropTypes.string,
     workerPoolURL: PropTypes.string,
+    commandURL: PropTypes.string,
   }
 
   state = {
     busyWorker: [],
-    workerPool: []
+    workerPool: [],
+    labelFilter: '',
+    concurrency: {},
+    message: ''
   }
 
   componentWillMount() {
@@ -82,14 +99,42 @@ export default class Processes extends React.Component {
     }
   }
 
+  get filteredWorkerPool() {
+    return this.state.workerPool.filter((pool) => poolMatchesLabels(pool, this.state.labelFilter));
+  }
+
   get workerCount() {
     let count = 0;
-    this.state.workerPool.map((pool) => {
+    this.filteredWorkerPool.map((pool) => {
       count += pool.worker_ids.length;
     });
     return count;
   }
 
+  // sendCommand sends a command to a pool, which runs it within a second.
+  sendCommand(pool, name, concurrency) {
+    if (!this.props.commandURL) {
+      return;
+    }
+    let body = `name=${name}`;
+    if (concurrency) {
+      body += `&concurrency=${encodeURIComponent(concurrency)}`;
+    }
+    fetch(`${this.props.commandURL}/${pool.worker_pool_id}`, {
+      method: 'post',
+      headers: {'Content-Type': 'application/x-www-form-urlencoded'},
+      body: body,
+    }).
+      then((resp) => resp.json()).
+      then((data) => {
+        if (data.error) {
+          this.setState({message: `Could not send ${name} to ${pool.host}: ${pool.pid}: ${data.error}`});
+          return;
+        }
+        this.setState({message: `Sent ${name} to ${pool.host}: ${pool.pid}. It runs within a second.`});
+      });
+  }
+
   getBusyPoolWorker(pool) {
     let workers = [];
     this.state.busyWorker.map((worker) => {
@@ -100,13 +145,27 @@ export default class Processes extends React.Component {
     return workers;
   }
 
+  get busyWorkerCount() {
+    let count = 0;
+    this.filteredWorkerPool.map((pool) => {
+      count += this.getBusyPoolWorker(pool).length;
+    });
+    return count;
+  }
+
   render() {
+    const workerPool = this.filteredWorkerPool;
     return (
       <section>
         <header>Processes</header>
-        <p>{this.state.workerPool.length} Worker process(es). {this.state.busyWorker.length} active worker(s) out of {this.workerCount}.</p>
+        <form className={styles.formInline} onSubmit={(e) => e.preventDefault()}>
+          <input type="text" className={styles.formControl} placeholder="Labels, eg version=1.4.2"
+            value={this.state.labelFilter} onChange={(e) => this.setState({labelFilter: e.target.value})}/>
+        </form>
+        {this.state.message && <div className={cx(styles.alert, styles.alertInfo)}>{this.state.message}</div>}
+        <p>{workerPool.length} Worker process(es). {this.busyWorkerCount} active worker(s) out of {this.workerCount}.</p>
         {
-          this.state.workerPool.map((pool) => {
+          workerPool.map((pool) => {
             let busyWorker = this.getBusyPoolWorker(pool);
             return (
               <div key={pool.worker_pool_id} className={cx(styles.panel, styles.panelDefault)}>
@@ -114,14 +173,51 @@ export default class Processes extends React.Component {
                   <table className={styles.table}>
                     <tbody>
                       <tr>
-                        <td>{pool.host}: {pool.pid}</td>
+                        <td>{pool.host}: {pool.pid}{pool.quiet && ' (quiet)'}</td>
                         <td>Started <UnixTime ts={pool.started_at}/></td>
                         <td>Last Heartbeat <UnixTime ts={pool.heartbeat_at}/></td>
                         <td>Concurrency {pool.concurrency}</td>
                       </tr>
+                      {
+                        this.props.commandURL &&
+                          <tr>
+                            <td colSpan="4">
+                              <form className={styles.formInline} onSubmit={(e) => { e.preventDefault(); this.sendCommand(pool, 'set_concurrency', this.state.concurrency[pool.worker_pool_id]); }}>
+                                <div className={styles.btnGroup} role="group">
+                                  {
+                                    pool.quiet ?
+                                      <button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.sendCommand(pool, 'resume')}>Resume</button> :
+                                      <button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.sendCommand(pool, 'quiet')}>Quiet</button>
+                                  }
+                                  <button type="button" className={cx(styles.btn, styles.btnDanger, styles.btnXs)} onClick={() => this.sendCommand(pool, 'stop')}>Stop</button>
+                                </div>
+                                <input type="number" min="1" className={cx(styles.formControl, styles.inputSm)} placeholder="Concurrency"
+                                  value={this.state.concurrency[pool.worker_pool_id] || ''}
+                                  onChange={(e) => this.setState({concurrency: Object.assign({}, this.state.concurrency, {[pool.worker_pool_id]: e.target.value})})}/>
+                                <button type="submit" className={cx(styles.btn, styles.btnDefault, styles.btnXs)}>Set Concurrency</button>
+                              </form>
+                            </td>
+                          </tr>
+                      }
                       <tr>
                         <td colSpan="4">Servicing <ShortList item={pool.job_names} />.</td>
                       </tr>
+                      {
+                        pool.labels &&
+                          <tr>
+                            <td colSpan="4">
+                              {
+                                Object.keys(pool.labels).sort().map((k) => {
+                                  const label = `${k}=${pool.labels[k]}`;
+                                  return (
+                                    <a key={k} href="#" className={styles.badge} title="Filter by this label"
+                                      onClick={(e) => { e.preventDefault(); this.setState({labelFilter: label}); }}>{label}</a>
+                                  );
+                                })
+                              }
+                            </td>
+                          </tr>
+                      }
                       <tr>
                         <td colSpan="4">{busyWorker.length} active worker(s) and {pool.worker_ids.length - busyWorker.length} idle.</td>
                       </tr>
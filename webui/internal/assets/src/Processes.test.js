@@ -1,6 +1,6 @@
 import './TestSetup';
 import expect from 'expect';
-import Processes from './Processes';
+import Processes, { poolMatchesLabels } from './Processes';
 import React from 'react';
 import { mount } from 'enzyme';
 
@@ -49,4 +49,97 @@ describe('Processes', () => {
     expect(busyWorkers.at(0).props().worker).toEqual(expectedBusyWorker);
     expect(processes.instance().getBusyPoolWorker(processes.state().workerPool[0])).toEqual(expectedBusyWorker);
   });
+
+  it('filters processes by label', () => {
+    let processes = mount(<Processes />);
+
+    processes.setState({
+      workerPool: [
+        {
+          worker_pool_id: '1',
+          started_at: 1467753603,
+          heartbeat_at: 1467753603,
+          job_names: ['job1'],
+          concurrency: 2,
+          host: 'web51',
+          pid: 123,
+          worker_ids: ['1', '2'],
+          labels: {version: '1.4.2', region: 'eu-west-1'}
+        },
+        {
+          worker_pool_id: '2',
+          started_at: 1467753603,
+          heartbeat_at: 1467753603,
+          job_names: ['job1'],
+          concurrency: 3,
+          host: 'web52',
+          pid: 456,
+          worker_ids: ['3', '4', '5'],
+          labels: {version: '1.5.0', region: 'eu-west-1'}
+        }
+      ]
+    });
+
+    expect(processes.instance().filteredWorkerPool.length).toEqual(2);
+    expect(processes.find('a').length).toEqual(4);
+
+    processes.find('input').simulate('change', {target: {value: 'version=1.5.0'}});
+    expect(processes.instance().filteredWorkerPool.map((pool) => pool.worker_pool_id)).toEqual(['2']);
+    expect(processes.instance().workerCount).toEqual(3);
+
+    processes.find('a').at(1).simulate('click');
+    expect(processes.state().labelFilter).toEqual('version=1.5.0');
+
+    processes.find('input').simulate('change', {target: {value: ''}});
+    processes.find('a').at(1).simulate('click');
+    expect(processes.state().labelFilter).toEqual('version=1.4.2');
+    expect(processes.instance().filteredWorkerPool.map((pool) => pool.worker_pool_id)).toEqual(['1']);
+  });
+
+  it('matches labels', () => {
+    const pool = {labels: {version: '1.4.2', region: 'eu-west-1'}};
+    expect(poolMatchesLabels(pool, '')).toEqual(true);
+    expect(poolMatchesLabels(pool, 'version=1.4.2 region=eu-west-1')).toEqual(true);
+    expect(poolMatchesLabels(pool, 'version=1.4')).toEqual(false);
+    expect(poolMatchesLabels(pool, 'eu-west-1')).toEqual(true);
+    expect(poolMatchesLabels(pool, 'region')).toEqual(true);
+    expect(poolMatchesLabels({}, 'region')).toEqual(false);
+  });
+
+  it('shows command buttons', () => {
+    let processes = mount(<Processes commandURL="/send_pool_command" />);
+
+    processes.setState({
+      workerPool: [
+        {
+          worker_pool_id: '1',
+          started_at: 1467753603,
+          heartbeat_at: 1467753603,
+          job_names: ['job1'],
+          concurrency: 2,
+          host: 'web51',
+          pid: 123,
+          worker_ids: ['1', '2'],
+          quiet: false
+        },
+        {
+          worker_pool_id: '2',
+          started_at: 1467753603,
+          heartbeat_at: 1467753603,
+          job_names: ['job1'],
+          concurrency: 3,
+          host: 'web52',
+          pid: 456,
+          worker_ids: ['3', '4', '5'],
+          quiet: true
+        }
+      ]
+    });
+
+    const buttons = processes.find('button').map((button) => button.text());
+    expect(buttons).toEqual(['Quiet', 'Stop', 'Set Concurrency', 'Resume', 'Stop', 'Set Concurrency']);
+
+    processes.find('input[type="number"]').at(1).simulate('change', {target: {value: '5'}});
+    expect(processes.state().concurrency).toEqual({'2': '5'});
+  });
 });
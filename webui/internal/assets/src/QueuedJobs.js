@@ -0,0 +1,155 @@
+import React from 'react';
+import PropTypes from 'prop-types';
+import PageList from './PageList';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class QueuedJobs extends React.Component {
+  static propTypes = {
+    jobName: PropTypes.string,
+    lane: PropTypes.string,
+    queue: PropTypes.string,
+    fetchURL: PropTypes.string,
+    deleteURL: PropTypes.string,
+    purgeURL: PropTypes.string,
+  }
+
+  state = {
+    selected: [],
+    page: 1,
+    count: 0,
+    jobs: []
+  }
+
+  // queueURL returns the URL of path under url, with params and the queue's lane or named queue, if any, as query
+  // params.
+  queueURL(url, path, params = {}) {
+    let query = Object.assign({}, params);
+    if (this.props.lane) {
+      query.lane = this.props.lane;
+    }
+    if (this.props.queue) {
+      query.queue = this.props.queue;
+    }
+    let qs = Object.keys(query).map((k) => `${k}=${encodeURIComponent(query[k])}`).join('&');
+    return qs ? `${url}/${path}?${qs}` : `${url}/${path}`;
+  }
+
+  fetch() {
+    if (!this.props.fetchURL) {
+      return;
+    }
+    fetch(this.queueURL(this.props.fetchURL, this.props.jobName, {page: this.state.page})).
+      then((resp) => resp.json()).
+      then((data) => {
+        this.setState({
+          selected: [],
+          count: data.count,
+          jobs: data.jobs
+        });
+      });
+  }
+
+  componentWillMount() {
+    this.fetch();
+  }
+
+  updatePage(page) {
+    this.setState({page: page}, this.fetch);
+  }
+
+  checked(job) {
+    return this.state.selected.includes(job);
+  }
+
+  check(job) {
+    var index = this.state.selected.indexOf(job);
+    if (index >= 0) {
+      this.state.selected.splice(index, 1);
+    } else {
+      this.state.selected.push(job);
+    }
+    this.setState({
+      selected: this.state.selected
+    });
+  }
+
+  checkAll() {
+    if (this.state.selected.length > 0) {
+      this.setState({selected: []});
+    } else {
+      this.state.jobs.map((job) => {
+        this.state.selected.push(job);
+      });
+      this.setState({
+        selected: this.state.selected
+      });
+    }
+  }
+
+  deleteSelected() {
+    let p = [];
+    this.state.selected.map((job) => {
+      if (!this.props.deleteURL) {
+        return;
+      }
+      p.push(fetch(this.queueURL(this.props.deleteURL, `${this.props.jobName}/${job.id}`), {method: 'post'}));
+    });
+
+    Promise.all(p).then(() => {
+      this.fetch();
+    });
+  }
+
+  purge() {
+    if (!this.props.purgeURL) {
+      return;
+    }
+    fetch(this.queueURL(this.props.purgeURL, this.props.jobName), {method: 'post'}).then(() => {
+      this.updatePage(1);
+    });
+  }
+
+  render() {
+    return (
+      <div>
+        <div className={cx(styles.panel, styles.panelDefault)}>
+          <div className={styles.panelHeading}>Queue: {this.props.jobName}{this.props.lane ? ` (${this.props.lane} lane)` : ''}{this.props.queue ? ` (${this.props.queue} queue)` : ''}</div>
+          <div className={styles.panelBody}>
+            <p>{this.state.count} job(s) queued.</p>
+            <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
+          </div>
+          <div className={styles.tableResponsive}>
+            <table className={styles.table}>
+              <tbody>
+                <tr>
+                  <th><input type="checkbox" checked={this.state.selected.length > 0} onChange={() => this.checkAll()}/></th>
+                  <th>ID</th>
+                  <th>Arguments</th>
+                  <th>Enqueued At</th>
+                </tr>
+                {
+                  this.state.jobs.map((job) => {
+                    return (
+                      <tr key={job.id}>
+                        <td><input type="checkbox" checked={this.checked(job)} onChange={() => this.check(job)}/></td>
+                        <td>{job.id}</td>
+                        <td>{JSON.stringify(job.args)}</td>
+                        <td><UnixTime ts={job.t} /></td>
+                      </tr>
+                    );
+                  })
+                }
+              </tbody>
+            </table>
+          </div>
+        </div>
+        <div className={styles.btnGroup} role="group">
+          <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.deleteSelected()}>Delete Selected Jobs</button>
+          <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.purge()}>Purge Queue</button>
+        </div>
+      </div>
+    );
+  }
+}
@@ -0,0 +1,54 @@
+import './TestSetup';
+import expect from 'expect';
+import QueuedJobs from './QueuedJobs';
+import React from 'react';
+import { mount } from 'enzyme';
+
+describe('QueuedJobs', () => {
+  it('shows jobs', () => {
+    let queuedJobs = mount(<QueuedJobs jobName="test" />);
+
+    expect(queuedJobs.state().jobs.length).toEqual(0);
+
+    queuedJobs.setState({
+      count: 2,
+      jobs: [
+        {id: 1, name: 'test', args: {}, t: 1467760821},
+        {id: 2, name: 'test', args: {}, t: 1467760822}
+      ]
+    });
+
+    expect(queuedJobs.state().jobs.length).toEqual(2);
+  });
+
+  it('selects jobs', () => {
+    let queuedJobs = mount(<QueuedJobs jobName="test" />);
+    let jobs = [
+      {id: 1, name: 'test', args: {}, t: 1467760821},
+      {id: 2, name: 'test', args: {}, t: 1467760822}
+    ];
+    queuedJobs.setState({count: 2, jobs: jobs});
+
+    queuedJobs.instance().checkAll();
+    expect(queuedJobs.state().selected.length).toEqual(2);
+
+    queuedJobs.instance().check(jobs[0]);
+    expect(queuedJobs.state().selected.length).toEqual(1);
+  });
+
+  it('builds URLs for lanes', () => {
+    let queuedJobs = mount(<QueuedJobs jobName="test" />);
+    expect(queuedJobs.instance().queueURL('/queued_jobs', 'test', {page: 2})).toEqual('/queued_jobs/test?page=2');
+    expect(queuedJobs.instance().queueURL('/purge_queue', 'test')).toEqual('/purge_queue/test');
+
+    queuedJobs = mount(<QueuedJobs jobName="test" lane="high" />);
+    expect(queuedJobs.instance().queueURL('/queued_jobs', 'test', {page: 2})).toEqual('/queued_jobs/test?page=2&lane=high');
+    expect(queuedJobs.instance().queueURL('/delete_queued_job', 'test/1')).toEqual('/delete_queued_job/test/1?lane=high');
+  });
+
+  it('builds URLs for named queues', () => {
+    let queuedJobs = mount(<QueuedJobs jobName="test" queue="enterprise" />);
+    expect(queuedJobs.instance().queueURL('/queued_jobs', 'test', {page: 2})).toEqual('/queued_jobs/test?page=2&queue=enterprise');
+    expect(queuedJobs.instance().queueURL('/purge_queue', 'test')).toEqual('/purge_queue/test?queue=enterprise');
+  });
+});
@@ -1,8 +1,20 @@
 import React from 'react';
 import PropTypes from 'prop-types';
+import { Link } from 'react-router';
 import styles from './bootstrap.min.css';
 import cx from './cx';
 
+function formatMs(ms) {
+  if (ms % 3600000 === 0) {
+    return `${ms / 3600000}h`;
+  } else if (ms % 60000 === 0) {
+    return `${ms / 60000}m`;
+  } else if (ms % 1000 === 0) {
+    return `${ms / 1000}s`;
+  }
+  return `${ms}ms`;
+}
+
 export default class Queues extends React.Component {
   static propTypes = {
     url: PropTypes.string,
@@ -31,6 +43,17 @@ export default class Queues extends React.Component {
     return count;
   }
 
+  rateLimitText(rateLimit) {
+    if (!rateLimit) {
+      return '';
+    }
+    let text = `${rateLimit.limit}/${formatMs(rateLimit.per_ms)}, ${Math.floor(rateLimit.tokens)} of ${rateLimit.burst} token(s) left`;
+    if (rateLimit.held_back_ms > 0) {
+      text += `, held back for ${(rateLimit.held_back_ms / 1000).toFixed(1)}s`;
+    }
+    return text;
+  }
+
   render() {
     return (
       <div className={cx(styles.panel, styles.panelDefault)}>
@@ -43,16 +66,28 @@ export default class Queues extends React.Component {
             <tbody>
               <tr>
                 <th>Name</th>
+                <th>Priority</th>
                 <th>Count</th>
                 <th>Latency (seconds)</th>
+                <th>Rate Limit</th>
               </tr>
               {
                 this.state.queues.map((queue) => {
                   return (
-                    <tr key={queue.job_name}>
-                      <td>{queue.job_name}</td>
+                    <tr key={`${queue.job_name}:${queue.lane || ''}:${queue.queue || ''}`}>
+                      <td>
+                        {
+                          queue.lane ?
+                            <Link to={{pathname: `/queues/${queue.job_name}`, query: {lane: queue.lane}}}>{queue.job_name} ({queue.lane} lane)</Link> :
+                            queue.queue ?
+                              <Link to={{pathname: `/queues/${queue.job_name}`, query: {queue: queue.queue}}}>{queue.job_name} ({queue.queue} queue)</Link> :
+                              <Link to={`/queues/${queue.job_name}`}>{queue.job_name}</Link>
+                        }
+                      </td>
+                      <td>{queue.priority || ''}</td>
                       <td>{queue.count}</td>
                       <td>{queue.latency}</td>
+                      <td>{this.rateLimitText(queue.rate_limit)}</td>
                     </tr>
                   );
                 })
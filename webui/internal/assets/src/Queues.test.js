@@ -19,4 +19,42 @@ describe('Queues', () => {
     expect(queues.state().queues.length).toEqual(2);
     expect(queues.instance().queuedCount).toEqual(3);
   });
+
+  it('shows lanes', () => {
+    let queues = mount(<Queues />);
+    queues.setState({
+      queues: [
+        {job_name: 'test', priority: 2, count: 1, latency: 0},
+        {job_name: 'test', lane: 'high', priority: 10, count: 2, latency: 0}
+      ]
+    });
+
+    expect(queues.find('tr').length).toEqual(3);
+    expect(queues.find('tr').at(2).find('td').at(0).text()).toEqual('test (high lane)');
+    expect(queues.instance().queuedCount).toEqual(3);
+  });
+
+  it('shows named queues', () => {
+    let queues = mount(<Queues />);
+    queues.setState({
+      queues: [
+        {job_name: 'test', priority: 2, count: 1, latency: 0},
+        {job_name: 'test', queue: 'enterprise', count: 4, latency: 3}
+      ]
+    });
+
+    expect(queues.find('tr').length).toEqual(3);
+    expect(queues.find('tr').at(2).find('td').at(0).text()).toEqual('test (enterprise queue)');
+    expect(queues.find('tr').at(2).find('a').length).toEqual(1);
+    expect(queues.instance().queuedCount).toEqual(5);
+  });
+
+  it('shows rate limits', () => {
+    let queues = mount(<Queues />);
+    expect(queues.instance().rateLimitText(undefined)).toEqual('');
+    expect(queues.instance().rateLimitText({limit: 10, per_ms: 1000, burst: 20, tokens: 4.5, held_back_ms: 0})).
+      toEqual('10/1s, 4 of 20 token(s) left');
+    expect(queues.instance().rateLimitText({limit: 2, per_ms: 60000, burst: 2, tokens: 0.25, held_back_ms: 22500})).
+      toEqual('2/1m, 0 of 2 token(s) left, held back for 22.5s');
+  });
 });
@@ -1,6 +1,7 @@
 import React from 'react';
 import PropTypes from 'prop-types';
 import PageList from './PageList';
+import JobFilter from './JobFilter';
 import UnixTime from './UnixTime';
 import styles from './bootstrap.min.css';
 import cx from './cx';
@@ -8,9 +9,12 @@ import cx from './cx';
 export default class RetryJobs extends React.Component {
   static propTypes = {
     url: PropTypes.string,
+    retryNowURL: PropTypes.string,
+    retryAllNowURL: PropTypes.string,
   }
 
   state = {
+    filter: '',
     page: 1,
     count: 0,
     jobs: []
@@ -20,7 +24,7 @@ export default class RetryJobs extends React.Component {
     if (!this.props.url) {
       return;
     }
-    fetch(`${this.props.url}?page=${this.state.page}`).
+    fetch(`${this.props.url}?page=${this.state.page}&${this.state.filter}`).
       then((resp) => resp.json()).
       then((data) => {
         this.setState({
@@ -38,12 +42,35 @@ export default class RetryJobs extends React.Component {
     this.setState({page: page}, this.fetch);
   }
 
+  updateFilter(filter) {
+    this.setState({page: 1, filter: filter}, this.fetch);
+  }
+
+  retryNow(job) {
+    if (!this.props.retryNowURL) {
+      return;
+    }
+    fetch(`${this.props.retryNowURL}/${job.retry_at}/${job.id}`, {method: 'post'}).then(() => {
+      this.fetch();
+    });
+  }
+
+  retryAllNow() {
+    if (!this.props.retryAllNowURL) {
+      return;
+    }
+    fetch(this.props.retryAllNowURL, {method: 'post'}).then(() => {
+      this.updatePage(1);
+    });
+  }
+
   render() {
     return (
       <div className={cx(styles.panel, styles.panelDefault)}>
         <div className={styles.panelHeading}>Retry Jobs</div>
         <div className={styles.panelBody}>
           <p>{this.state.count} job(s) scheduled to be retried.</p>
+          <JobFilter onChange={(filter) => this.updateFilter(filter)}/>
           <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
         </div>
         <div className={styles.tableResponsive}>
@@ -54,6 +81,7 @@ export default class RetryJobs extends React.Component {
                 <th>Arguments</th>
                 <th>Error</th>
                 <th>Retry At</th>
+                <th><button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.retryAllNow()}>Retry All Now</button></th>
               </tr>
               {
                 this.state.jobs.map((job) => {
@@ -63,6 +91,7 @@ export default class RetryJobs extends React.Component {
                       <td>{JSON.stringify(job.args)}</td>
                       <td>{job.err}</td>
                       <td><UnixTime ts={job.t} /></td>
+                      <td><button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.retryNow(job)}>Retry Now</button></td>
                     </tr>
                   );
                 })
@@ -1,6 +1,7 @@
 import React from 'react';
 import PropTypes from 'prop-types';
 import PageList from './PageList';
+import JobFilter from './JobFilter';
 import UnixTime from './UnixTime';
 import styles from './bootstrap.min.css';
 import cx from './cx';
@@ -8,9 +9,12 @@ import cx from './cx';
 export default class ScheduledJobs extends React.Component {
   static propTypes = {
     url: PropTypes.string,
+    runNowURL: PropTypes.string,
+    runAllNowURL: PropTypes.string,
   }
 
   state = {
+    filter: '',
     page: 1,
     count: 0,
     jobs: []
@@ -20,7 +24,7 @@ export default class ScheduledJobs extends React.Component {
     if (!this.props.url) {
       return;
     }
-    fetch(`${this.props.url}?page=${this.state.page}`).
+    fetch(`${this.props.url}?page=${this.state.page}&${this.state.filter}`).
       then((resp) => resp.json()).
       then((data) => {
         this.setState({
@@ -38,12 +42,35 @@ export default class ScheduledJobs extends React.Component {
     this.setState({page: page}, this.fetch);
   }
 
+  updateFilter(filter) {
+    this.setState({page: 1, filter: filter}, this.fetch);
+  }
+
+  runNow(job) {
+    if (!this.props.runNowURL) {
+      return;
+    }
+    fetch(`${this.props.runNowURL}/${job.run_at}/${job.id}`, {method: 'post'}).then(() => {
+      this.fetch();
+    });
+  }
+
+  runAllNow() {
+    if (!this.props.runAllNowURL) {
+      return;
+    }
+    fetch(this.props.runAllNowURL, {method: 'post'}).then(() => {
+      this.updatePage(1);
+    });
+  }
+
   render() {
     return (
       <div className={cx(styles.panel, styles.panelDefault)}>
         <div className={styles.panelHeading}>Scheduled Jobs</div>
         <div className={styles.panelBody}>
           <p>{this.state.count} job(s) scheduled.</p>
+          <JobFilter onChange={(filter) => this.updateFilter(filter)}/>
           <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
         </div>
         <div className={styles.tableResponsive}>
@@ -53,6 +80,7 @@ export default class ScheduledJobs extends React.Component {
                 <th>Name</th>
                 <th>Arguments</th>
                 <th>Scheduled For</th>
+                <th><button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.runAllNow()}>Run All Now</button></th>
               </tr>
               {
                 this.state.jobs.map((job) => {
@@ -61,6 +89,7 @@ export default class ScheduledJobs extends React.Component {
                       <td>{job.name}</td>
                       <td>{JSON.stringify(job.args)}</td>
                       <td><UnixTime ts={job.run_at} /></td>
+                      <td><button type="button" className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.runNow(job)}>Run Now</button></td>
                     </tr>
                   );
                 })
@@ -0,0 +1,20 @@
+// The server sets a CSRF token in this cookie, and only accepts POSTs that send it back in this header.
+export const csrfCookie = 'work_csrf_token';
+export const csrfHeader = 'X-CSRF-Token';
+
+export function csrfToken(cookie) {
+  let match = new RegExp(`(?:^|;\\s*)${csrfCookie}=([^;]*)`).exec(cookie || '');
+  return match ? decodeURIComponent(match[1]) : '';
+}
+
+// withCSRF wraps fetch so that every request other than a GET sends the CSRF token.
+export default (fetch, doc) => {
+  return (url, opts = {}) => {
+    let method = (opts.method || 'get').toLowerCase();
+    if (method === 'get' || method === 'head') {
+      return fetch(url, opts);
+    }
+    let headers = Object.assign({}, opts.headers, {[csrfHeader]: csrfToken(doc.cookie)});
+    return fetch(url, Object.assign({}, opts, {headers: headers, credentials: 'same-origin'}));
+  };
+};
@@ -0,0 +1,26 @@
+import './TestSetup';
+import expect from 'expect';
+import withCSRF, { csrfToken } from './csrf';
+
+describe('csrf', () => {
+  it('reads the token from the cookie', () => {
+    expect(csrfToken('a=1; work_csrf_token=abc%3D; b=2')).toEqual('abc=');
+    expect(csrfToken('work_csrf_token=xyz')).toEqual('xyz');
+    expect(csrfToken('not_work_csrf_token=xyz')).toEqual('');
+    expect(csrfToken('')).toEqual('');
+  });
+
+  it('sends the token with posts only', () => {
+    let calls = [];
+    let fetch = withCSRF((url, opts) => calls.push({url, opts}), {cookie: 'work_csrf_token=abc'});
+
+    fetch('/queues');
+    fetch('/retry_all_dead_jobs', {method: 'post'});
+    fetch('/send_pool_command/1', {method: 'post', headers: {'Content-Type': 'application/x-www-form-urlencoded'}});
+
+    expect(calls.length).toEqual(3);
+    expect(calls[0].opts).toEqual({});
+    expect(calls[1].opts.headers).toEqual({'X-CSRF-Token': 'abc'});
+    expect(calls[2].opts.headers).toEqual({'Content-Type': 'application/x-www-form-urlencoded', 'X-CSRF-Token': 'abc'});
+  });
+});
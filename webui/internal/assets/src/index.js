@@ -4,11 +4,17 @@ import { render } from 'react-dom';
 import Processes from './Processes';
 import DeadJobs from './DeadJobs';
 import Queues from './Queues';
+import QueuedJobs from './QueuedJobs';
 import RetryJobs from './RetryJobs';
 import ScheduledJobs from './ScheduledJobs';
+import PeriodicSchedules from './PeriodicSchedules';
 import { Router, Route, Link, IndexRedirect, hashHistory } from 'react-router';
 import styles from './bootstrap.min.css';
 import cx from './cx';
+import withCSRF from './csrf';
+
+// The components fetch with the global fetch, so that the server's CSRF token is sent along with every POST.
+window.fetch = withCSRF(window.fetch.bind(window), document);
 
 class App extends React.Component {
   static propTypes = {
@@ -32,6 +38,7 @@ class App extends React.Component {
                 <li><Link to="/retry_jobs">Retry Jobs</Link></li>
                 <li><Link to="/scheduled_jobs">Scheduled Jobs</Link></li>
                 <li><Link to="/dead_jobs">Dead Jobs</Link></li>
+                <li><Link to="/periodic_schedules">Periodic Schedules</Link></li>
               </ul>
             </nav>
           </aside>
@@ -46,10 +53,20 @@ class App extends React.Component {
 render(
   <Router history={hashHistory}>
     <Route path="/" component={App}>
-      <Route path="/processes" component={ () => <Processes busyWorkerURL="/busy_workers" workerPoolURL="/worker_pools" /> } />
+      <Route path="/processes" component={ () => <Processes busyWorkerURL="/busy_workers" workerPoolURL="/worker_pools" commandURL="/send_pool_command" /> } />
       <Route path="/queues" component={ () => <Queues url="/queues" /> } />
-      <Route path="/retry_jobs" component={ () => <RetryJobs url="/retry_jobs" /> } />
-      <Route path="/scheduled_jobs" component={ () => <ScheduledJobs url="/scheduled_jobs" /> } />
+      <Route path="/queues/:jobName" component={ (props) =>
+        <QueuedJobs
+          jobName={props.params.jobName}
+          lane={props.location.query.lane}
+          queue={props.location.query.queue}
+          fetchURL="/queued_jobs"
+          deleteURL="/delete_queued_job"
+          purgeURL="/purge_queue"
+        />
+      } />
+      <Route path="/retry_jobs" component={ () => <RetryJobs url="/retry_jobs" retryNowURL="/retry_now" retryAllNowURL="/retry_all_retry_jobs" /> } />
+      <Route path="/scheduled_jobs" component={ () => <ScheduledJobs url="/scheduled_jobs" runNowURL="/run_scheduled_now" runAllNowURL="/run_all_scheduled_jobs" /> } />
       <Route path="/dead_jobs" component={ () =>
         <DeadJobs
           fetchURL="/dead_jobs"
@@ -57,6 +74,18 @@ render(
           retryAllURL="/retry_all_dead_jobs"
           deleteURL="/delete_dead_job"
           deleteAllURL="/delete_all_dead_jobs"
+          deleteMatchingURL="/delete_dead_jobs"
+          retryMatchingURL="/retry_dead_jobs"
+        />
+      } />
+      <Route path="/periodic_schedules" component={ () =>
+        <PeriodicSchedules
+          fetchURL="/periodic_schedules"
+          addURL="/add_periodic_schedule"
+          updateURL="/update_periodic_schedule"
+          enableURL="/enable_periodic_schedule"
+          disableURL="/disable_periodic_schedule"
+          deleteURL="/delete_periodic_schedule"
         />
       } />
       <IndexRedirect from="" to="/processes" />
@@ -2,16 +2,15 @@ package webui
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"sync"
 
 	"github.com/braintree/manners"
 	"github.com/gocraft/web"
-	"github.com/gocraft/work"
-	"github.com/gocraft/work/webui/internal/assets"
 	"github.com/gomodule/redigo/redis"
+	"github.com/kit-x/work"
+	"github.com/kit-x/work/webui/internal/assets"
 )
 
 // Server implements an HTTP server which exposes a JSON API to view and manage gocraft/work items.
@@ -23,14 +22,23 @@ type Server struct {
 	server    *manners.GracefulServer
 	wg        sync.WaitGroup
 	router    *web.Router
+	options   ServerOptions
 }
 
 type context struct {
 	*Server
+	user string
+	role Role
 }
 
 // NewServer creates and returns a new server. The 'namespace' param is the redis namespace to use. The hostPort param is the address to bind on to expose the API.
 func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
+	return NewServerWithOptions(namespace, pool, hostPort, ServerOptions{})
+}
+
+// NewServerWithOptions creates a new server as per NewServer, but permits you to specify additional options such as
+// authentication.
+func NewServerWithOptions(namespace string, pool *redis.Pool, hostPort string, options ServerOptions) *Server {
 	router := web.New(context{})
 	server := &Server{
 		namespace: namespace,
@@ -39,6 +47,7 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 		hostPort:  hostPort,
 		server:    manners.NewWithServer(&http.Server{Addr: hostPort, Handler: router}),
 		router:    router,
+		options:   options,
 	}
 
 	router.Middleware(func(c *context, rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
@@ -49,16 +58,41 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 		rw.Header().Set("Content-Type", "application/json; charset=utf-8")
 		next(rw, r)
 	})
+	router.Middleware((*context).authenticate)
+
+	// Viewers can GET everything.
 	router.Get("/queues", (*context).queues)
 	router.Get("/worker_pools", (*context).workerPools)
 	router.Get("/busy_workers", (*context).busyWorkers)
+	router.Get("/queued_jobs/:job_name", (*context).queuedJobs)
 	router.Get("/retry_jobs", (*context).retryJobs)
 	router.Get("/scheduled_jobs", (*context).scheduledJobs)
 	router.Get("/dead_jobs", (*context).deadJobs)
-	router.Post("/delete_dead_job/:died_at:\\d.*/:job_id", (*context).deleteDeadJob)
-	router.Post("/retry_dead_job/:died_at:\\d.*/:job_id", (*context).retryDeadJob)
-	router.Post("/delete_all_dead_jobs", (*context).deleteAllDeadJobs)
-	router.Post("/retry_all_dead_jobs", (*context).retryAllDeadJobs)
+	router.Get("/periodic_schedules", (*context).periodicSchedules)
+
+	operatorRouter := router.Subrouter(context{}, "")
+	operatorRouter.Middleware(requireRole(RoleOperator))
+	operatorRouter.Post("/delete_dead_job/:died_at:\\d.*/:job_id", (*context).deleteDeadJob)
+	operatorRouter.Post("/retry_dead_job/:died_at:\\d.*/:job_id", (*context).retryDeadJob)
+	operatorRouter.Post("/retry_now/:retry_at:\\d.*/:job_id", (*context).retryNow)
+	operatorRouter.Post("/run_scheduled_now/:run_at:\\d.*/:job_id", (*context).runScheduledNow)
+	operatorRouter.Post("/delete_queued_job/:job_name/:job_id", (*context).deleteQueuedJob)
+
+	adminRouter := router.Subrouter(context{}, "")
+	adminRouter.Middleware(requireRole(RoleAdmin))
+	adminRouter.Post("/send_pool_command/:worker_pool_id", (*context).sendPoolCommand)
+	adminRouter.Post("/delete_all_dead_jobs", (*context).deleteAllDeadJobs)
+	adminRouter.Post("/retry_all_dead_jobs", (*context).retryAllDeadJobs)
+	adminRouter.Post("/delete_dead_jobs", (*context).deleteDeadJobs)
+	adminRouter.Post("/retry_dead_jobs", (*context).retryDeadJobs)
+	adminRouter.Post("/retry_all_retry_jobs", (*context).retryAllRetryJobs)
+	adminRouter.Post("/run_all_scheduled_jobs", (*context).runAllScheduledJobs)
+	adminRouter.Post("/purge_queue/:job_name", (*context).purgeQueue)
+	adminRouter.Post("/add_periodic_schedule", (*context).addPeriodicSchedule)
+	adminRouter.Post("/update_periodic_schedule/:id", (*context).updatePeriodicSchedule)
+	adminRouter.Post("/enable_periodic_schedule/:id", (*context).enablePeriodicSchedule)
+	adminRouter.Post("/disable_periodic_schedule/:id", (*context).disablePeriodicSchedule)
+	adminRouter.Post("/delete_periodic_schedule/:id", (*context).deletePeriodicSchedule)
 
 	//
 	// Build the HTML page:
@@ -101,6 +135,17 @@ func (c *context) workerPools(rw web.ResponseWriter, r *web.Request) {
 	render(rw, response, err)
 }
 
+func (c *context) sendPoolCommand(rw web.ResponseWriter, r *web.Request) {
+	command, err := parsePoolCommand(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	err = c.client.SendPoolCommand(r.PathParams["worker_pool_id"], command)
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
 func (c *context) busyWorkers(rw web.ResponseWriter, r *web.Request) {
 	observations, err := c.client.WorkerObservations()
 	if err != nil {
@@ -118,14 +163,35 @@ func (c *context) busyWorkers(rw web.ResponseWriter, r *web.Request) {
 	render(rw, busyObservations, err)
 }
 
-func (c *context) retryJobs(rw web.ResponseWriter, r *web.Request) {
+func (c *context) queuedJobs(rw web.ResponseWriter, r *web.Request) {
 	page, err := parsePage(r)
 	if err != nil {
 		renderError(rw, err)
 		return
 	}
 
-	jobs, count, err := c.client.RetryJobs(page)
+	jobs, count, err := c.client.QueuedJobsIn(requestQueue(r), page)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	response := struct {
+		Count int64       `json:"count"`
+		Jobs  []*work.Job `json:"jobs"`
+	}{Count: count, Jobs: jobs}
+
+	render(rw, response, err)
+}
+
+func (c *context) retryJobs(rw web.ResponseWriter, r *web.Request) {
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	jobs, count, err := c.client.FilteredRetryJobs(filter)
 	if err != nil {
 		renderError(rw, err)
 		return
@@ -140,13 +206,13 @@ func (c *context) retryJobs(rw web.ResponseWriter, r *web.Request) {
 }
 
 func (c *context) scheduledJobs(rw web.ResponseWriter, r *web.Request) {
-	page, err := parsePage(r)
+	filter, err := parseJobFilter(r)
 	if err != nil {
 		renderError(rw, err)
 		return
 	}
 
-	jobs, count, err := c.client.ScheduledJobs(page)
+	jobs, count, err := c.client.FilteredScheduledJobs(filter)
 	if err != nil {
 		renderError(rw, err)
 		return
@@ -161,13 +227,13 @@ func (c *context) scheduledJobs(rw web.ResponseWriter, r *web.Request) {
 }
 
 func (c *context) deadJobs(rw web.ResponseWriter, r *web.Request) {
-	page, err := parsePage(r)
+	filter, err := parseJobFilter(r)
 	if err != nil {
 		renderError(rw, err)
 		return
 	}
 
-	jobs, count, err := c.client.DeadJobs(page)
+	jobs, count, err := c.client.FilteredDeadJobs(filter)
 	if err != nil {
 		renderError(rw, err)
 		return
@@ -215,6 +281,118 @@ func (c *context) retryAllDeadJobs(rw web.ResponseWriter, r *web.Request) {
 	render(rw, map[string]string{"status": "ok"}, err)
 }
 
+func (c *context) retryNow(rw web.ResponseWriter, r *web.Request) {
+	retryAt, err := strconv.ParseInt(r.PathParams["retry_at"], 10, 64)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	err = c.client.RetryNow(retryAt, r.PathParams["job_id"])
+
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) retryAllRetryJobs(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.RetryAllRetryJobs()
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) runScheduledNow(rw web.ResponseWriter, r *web.Request) {
+	runAt, err := strconv.ParseInt(r.PathParams["run_at"], 10, 64)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	err = c.client.RunScheduledNow(runAt, r.PathParams["job_id"])
+
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) runAllScheduledJobs(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.RunAllScheduledJobs()
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) deleteDeadJobs(rw web.ResponseWriter, r *web.Request) {
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	count, err := c.client.DeleteDeadJobs(filter)
+	render(rw, map[string]interface{}{"status": "ok", "count": count}, err)
+}
+
+func (c *context) retryDeadJobs(rw web.ResponseWriter, r *web.Request) {
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	count, err := c.client.RetryDeadJobs(filter)
+	render(rw, map[string]interface{}{"status": "ok", "count": count}, err)
+}
+
+func (c *context) deleteQueuedJob(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.DeleteQueuedJobIn(requestQueue(r), r.PathParams["job_id"])
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) purgeQueue(rw web.ResponseWriter, r *web.Request) {
+	count, err := c.client.PurgeQueueIn(requestQueue(r))
+	render(rw, map[string]interface{}{"status": "ok", "count": count}, err)
+}
+
+func (c *context) periodicSchedules(rw web.ResponseWriter, r *web.Request) {
+	response, err := c.client.PeriodicSchedules()
+	render(rw, response, err)
+}
+
+func (c *context) addPeriodicSchedule(rw web.ResponseWriter, r *web.Request) {
+	s, err := parsePeriodicSchedule(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	response, err := c.client.AddPeriodicScheduleWithOptions(s.JobName, s.Spec, s.Args, work.PeriodicJobOptions{
+		Misfire:     s.Misfire,
+		MaxMisfires: s.MaxMisfires,
+	})
+	render(rw, response, err)
+}
+
+func (c *context) updatePeriodicSchedule(rw web.ResponseWriter, r *web.Request) {
+	s, err := parsePeriodicSchedule(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+	s.ID = r.PathParams["id"]
+
+	err = c.client.UpdatePeriodicSchedule(s)
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) enablePeriodicSchedule(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.EnablePeriodicSchedule(r.PathParams["id"])
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) disablePeriodicSchedule(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.DisablePeriodicSchedule(r.PathParams["id"])
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) deletePeriodicSchedule(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.DeletePeriodicSchedule(r.PathParams["id"])
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
 func render(rw web.ResponseWriter, jsonable interface{}, err error) {
 	if err != nil {
 		renderError(rw, err)
@@ -230,8 +408,21 @@ func render(rw web.ResponseWriter, jsonable interface{}, err error) {
 }
 
 func renderError(rw http.ResponseWriter, err error) {
-	rw.WriteHeader(500)
-	fmt.Fprintf(rw, `{"error": "%s"}`, err.Error())
+	renderErrorStatus(rw, 500, err)
+}
+
+func renderErrorStatus(rw http.ResponseWriter, status int, err error) {
+	// Error messages can have quotes in them, eg those of an unknown role.
+	jsonData, _ := json.Marshal(map[string]string{"error": err.Error()})
+	rw.WriteHeader(status)
+	rw.Write(jsonData)
+}
+
+// requestQueue returns the queue named by the job_name path param, or one of its lanes or named queues if the lane or
+// queue param is set.
+func requestQueue(r *web.Request) *work.Queue {
+	query := r.URL.Query()
+	return &work.Queue{JobName: r.PathParams["job_name"], Lane: query.Get("lane"), Queue: query.Get("queue")}
 }
 
 func parsePage(r *web.Request) (uint, error) {
@@ -248,3 +439,85 @@ func parsePage(r *web.Request) (uint, error) {
 	page, err := strconv.ParseUint(pageStr, 10, 0)
 	return uint(page), err
 }
+
+func parseJobFilter(r *web.Request) (*work.JobFilter, error) {
+	page, err := parsePage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &work.JobFilter{
+		JobName:       r.Form.Get("job_name"),
+		ErrorContains: r.Form.Get("error"),
+		ArgKey:        r.Form.Get("arg_key"),
+		ArgValue:      r.Form.Get("arg_value"),
+		Page:          page,
+	}
+
+	if s := r.Form.Get("per_page"); s != "" {
+		perPage, err := strconv.ParseUint(s, 10, 0)
+		if err != nil {
+			return nil, err
+		}
+		filter.PageSize = uint(perPage)
+	}
+	if s := r.Form.Get("since"); s != "" {
+		if filter.Since, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if s := r.Form.Get("until"); s != "" {
+		if filter.Until, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	return filter, nil
+}
+
+// parsePoolCommand reads a pool command from the name and concurrency form values.
+func parsePoolCommand(r *web.Request) (work.PoolCommand, error) {
+	if err := r.ParseForm(); err != nil {
+		return work.PoolCommand{}, err
+	}
+
+	command := work.PoolCommand{Name: work.PoolCommandName(r.Form.Get("name"))}
+	if v := r.Form.Get("concurrency"); v != "" {
+		concurrency, err := strconv.ParseUint(v, 10, 0)
+		if err != nil {
+			return work.PoolCommand{}, err
+		}
+		command.Concurrency = uint(concurrency)
+	}
+	return command, nil
+}
+
+// parsePeriodicSchedule reads a schedule from the job_name, spec, args, misfire and max_misfires form values. args is
+// a JSON object.
+func parsePeriodicSchedule(r *web.Request) (*work.PeriodicSchedule, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	s := &work.PeriodicSchedule{
+		JobName: r.Form.Get("job_name"),
+		Spec:    r.Form.Get("spec"),
+		Misfire: work.MisfirePolicy(r.Form.Get("misfire")),
+	}
+
+	if v := r.Form.Get("max_misfires"); v != "" {
+		maxMisfires, err := strconv.ParseUint(v, 10, 0)
+		if err != nil {
+			return nil, err
+		}
+		s.MaxMisfires = uint(maxMisfires)
+	}
+
+	if args := r.Form.Get("args"); args != "" {
+		if err := json.Unmarshal([]byte(args), &s.Args); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
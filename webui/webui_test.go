@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
-	"github.com/gocraft/work"
 	"github.com/gomodule/redigo/redis"
+	"github.com/kit-x/work"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -120,6 +121,52 @@ func TestWebUIWorkerPools(t *testing.T) {
 	// NOTE: WorkerPoolStatus is tested elsewhere.
 }
 
+func TestWebUISendPoolCommand(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *work.Job) error { return nil })
+	wp.Start()
+	defer wp.Stop()
+
+	time.Sleep(20 * time.Millisecond)
+
+	hbs, err := work.NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(hbs))
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/send_pool_command/"+hbs[0].WorkerPoolID, strings.NewReader("name=set_concurrency&concurrency=2"))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/send_pool_command/"+hbs[0].WorkerPoolID, strings.NewReader("name=wat"))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/send_pool_command/nope", strings.NewReader("name=quiet"))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+
+	conn := pool.Get()
+	defer conn.Close()
+	commands, err := redis.Strings(conn.Do("LRANGE", "work:worker_pools:"+hbs[0].WorkerPoolID+":commands", 0, -1))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`{"name":"set_concurrency","concurrency":2}`}, commands)
+}
+
 func TestWebUIBusyWorkers(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -312,11 +359,13 @@ func TestWebUIDeadJobs(t *testing.T) {
 	// Ok, now let's retry one and delete one.
 	recorder = httptest.NewRecorder()
 	request, _ = http.NewRequest("POST", fmt.Sprintf("/delete_dead_job/%d/%s", diedAt0, id0), nil)
+	setCSRFToken(request, "token")
 	s.router.ServeHTTP(recorder, request)
 	assert.Equal(t, 200, recorder.Code)
 
 	recorder = httptest.NewRecorder()
 	request, _ = http.NewRequest("POST", fmt.Sprintf("/retry_dead_job/%d/%s", diedAt1, id1), nil)
+	setCSRFToken(request, "token")
 	s.router.ServeHTTP(recorder, request)
 	assert.Equal(t, 200, recorder.Code)
 
@@ -388,6 +437,7 @@ func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 	// Ok, now let's retry all
 	recorder = httptest.NewRecorder()
 	request, _ = http.NewRequest("POST", "/retry_all_dead_jobs", nil)
+	setCSRFToken(request, "token")
 	s.router.ServeHTTP(recorder, request)
 	assert.Equal(t, 200, recorder.Code)
 
@@ -434,6 +484,7 @@ func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 	// Now delete them:
 	recorder = httptest.NewRecorder()
 	request, _ = http.NewRequest("POST", "/delete_all_dead_jobs", nil)
+	setCSRFToken(request, "token")
 	s.router.ServeHTTP(recorder, request)
 	assert.Equal(t, 200, recorder.Code)
 
@@ -447,6 +498,458 @@ func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 	assert.EqualValues(t, 0, res.Count)
 }
 
+func TestWebUIDeadJobsFilter(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", work.Q{"region": "eu"})
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.Enqueue("foo", work.Q{"region": "us"})
+	assert.NoError(t, err)
+
+	wp := work.NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("wat", work.JobOptions{Priority: 1, MaxFails: 1}, func(job *work.Job) error {
+		return fmt.Errorf("ohno wat")
+	})
+	wp.JobWithOptions("foo", work.JobOptions{Priority: 1, MaxFails: 1}, func(job *work.Job) error {
+		return fmt.Errorf("ohno foo")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	s := NewServer(ns, pool, ":6666")
+
+	var res struct {
+		Count int64 `json:"count"`
+		Jobs  []struct {
+			Name string `json:"name"`
+		} `json:"jobs"`
+	}
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/dead_jobs?job_name=wat&per_page=2", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, res.Count)
+	assert.Equal(t, 2, len(res.Jobs))
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/dead_jobs?error=foo&arg_key=region&arg_value=us", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/dead_jobs?since=wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+
+	// Retry the wat jobs and delete the foo job.
+	var bulkRes struct {
+		Count int64 `json:"count"`
+	}
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/retry_dead_jobs", strings.NewReader("job_name=wat"))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &bulkRes)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, bulkRes.Count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/delete_dead_jobs", strings.NewReader("job_name=foo"))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &bulkRes)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, bulkRes.Count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/dead_jobs", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.Count)
+}
+
+func TestWebUIRetryAndRunNow(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	scheduled, err := enqueuer.EnqueueIn("wat", 1000, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("wat", 1000, nil)
+	assert.NoError(t, err)
+
+	wp := work.NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *work.Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := work.NewClient(ns, pool)
+	retryJobs, _, err := client.RetryJobs(1)
+	assert.NoError(t, err)
+	if !assert.Equal(t, 2, len(retryJobs)) {
+		return
+	}
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/retry_now/%d/%s", retryJobs[0].RetryAt, retryJobs[0].ID), nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	_, count, err := client.RetryJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/retry_all_retry_jobs", nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	_, count, err = client.RetryJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", fmt.Sprintf("/run_scheduled_now/%d/%s", scheduled.RunAt, scheduled.ID), nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	_, count, err = client.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/run_all_scheduled_jobs", nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	_, count, err = client.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+
+	_, count, err = client.QueuedJobs("wat", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, count)
+}
+
+func TestWebUIQueuedJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	j1, err := enqueuer.Enqueue("wat", work.Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", work.Q{"a": 2})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/queued_jobs/wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Count int64 `json:"count"`
+		Jobs  []struct {
+			Name string `json:"name"`
+			ID   string `json:"id"`
+		} `json:"jobs"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, res.Count)
+	if assert.Equal(t, 2, len(res.Jobs)) {
+		assert.Equal(t, j1.ID, res.Jobs[0].ID)
+		assert.Equal(t, "wat", res.Jobs[0].Name)
+	}
+
+	// Delete one, then purge the rest.
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", fmt.Sprintf("/delete_queued_job/wat/%s", j1.ID), nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/queued_jobs/wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/purge_queue/wat", nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var purged struct {
+		Count int64 `json:"count"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &purged)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, purged.Count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/queued_jobs/wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, res.Count)
+
+	// The other queue is untouched.
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/queued_jobs/foo", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+}
+
+func TestWebUIQueuedJobsInLane(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", work.JobOptions{Lanes: map[string]uint{"high": 10}}, func(job *work.Job) error { return nil })
+	wp.Start()
+	wp.Stop()
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	j, err := enqueuer.EnqueueToLane("wat", "high", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueToLane("wat", "high", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	var res struct {
+		Count int64 `json:"count"`
+	}
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/queued_jobs/wat?lane=high", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, res.Count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", fmt.Sprintf("/delete_queued_job/wat/%s?lane=high", j.ID), nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/purge_queue/wat?lane=high", nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+
+	// The job queue itself is untouched.
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/queued_jobs/wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+}
+
+func TestWebUIQueuedJobsInNamedQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	j, err := enqueuer.EnqueueTo("enterprise", "wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueTo("enterprise", "wat", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	var res struct {
+		Count int64 `json:"count"`
+	}
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/queued_jobs/wat?queue=enterprise", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, res.Count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", fmt.Sprintf("/delete_queued_job/wat/%s?queue=enterprise", j.ID), nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/purge_queue/wat?queue=enterprise", nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+
+	// The job queue itself is untouched.
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/queued_jobs/wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+}
+
+func TestWebUIPeriodicSchedules(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/add_periodic_schedule", strings.NewReader(`job_name=report&spec=0+0+9+*+*+*&args={"region":"eu"}&misfire=run_all&max_misfires=5`))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var added work.PeriodicSchedule
+	err := json.Unmarshal(recorder.Body.Bytes(), &added)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, added.ID)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/add_periodic_schedule", strings.NewReader("job_name=report&spec=wat"))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/add_periodic_schedule", strings.NewReader("job_name=report&spec=0+0+9+*+*+*&misfire=wat"))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/disable_periodic_schedule/"+added.ID, nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/periodic_schedules", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res []*work.PeriodicSchedule
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(res)) {
+		assert.Equal(t, "report", res[0].JobName)
+		assert.Equal(t, "eu", res[0].Args["region"])
+		assert.Equal(t, work.MisfireRunAll, res[0].Misfire)
+		assert.EqualValues(t, 5, res[0].MaxMisfires)
+		assert.True(t, res[0].Disabled)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/update_periodic_schedule/"+added.ID, strings.NewReader("job_name=summary&spec=0+0+10+*+*+*"))
+	setCSRFToken(request, "token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/periodic_schedules", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(res)) {
+		assert.Equal(t, "summary", res[0].JobName)
+		assert.Equal(t, "0 0 10 * * *", res[0].Spec)
+		assert.True(t, res[0].Disabled)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/enable_periodic_schedule/"+added.ID, nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/periodic_schedules", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	res = nil
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(res)) {
+		assert.False(t, res[0].Disabled)
+		assert.True(t, res[0].NextFireAt > 0)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/delete_periodic_schedule/"+added.ID, nil)
+	setCSRFToken(request, "token")
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	schedules, err := work.NewClient(ns, pool).PeriodicSchedules()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(schedules))
+}
+
 func TestWebUIAssets(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
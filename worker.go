@@ -1,35 +1,62 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
 
-const fetchKeysPerJobType = 6
+const fetchKeysPerJobType = 12
 
 type worker struct {
 	workerID      string
 	poolID        string
 	namespace     string
 	pool          *redis.Pool
-	jobTypes      map[string]*jobType
 	sleepBackoffs []int64
-	middleware    []*middlewareHandler
 	contextType   reflect.Type
 
+	deadJobRetention DeadJobRetention
+
+	// mtx guards the job types and everything built from them, which can change while the worker is started.
+	mtx              sync.Mutex
+	jobTypes         map[string]*jobType
+	middleware       []*middlewareHandler
 	redisFetchScript *redis.Script
 	sampler          prioritySampler
+	queues           map[string]uint // named queues to fetch from, with their priority, see WorkerPoolOptions.Queues
+	queuesOnly       bool
 	*observer
 
+	busySince int64 // When the job being processed started, in unix nanoseconds, or 0 while idle. Accessed atomically.
+	busyTotal int64 // Nanoseconds spent processing finished jobs. Accessed atomically.
+	stopping  int32 // 1 once stop is called, so that no more jobs are fetched. Accessed atomically.
+	quiet     int32 // 1 while the pool is quiet, so that no jobs are fetched. Accessed atomically.
+
+	lastFetchAt int64 // When the worker last asked redis for a job successfully, in unix nanoseconds. Accessed atomically.
+
+	// The job being processed, which can be handed back to its queue while it runs, see WorkerPool.StopWithContext.
+	jobMtx     sync.Mutex
+	currentJob *Job
+	cancelJob  context.CancelFunc
+	abandoned  bool
+
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
 
-	drainChan        chan struct{}
-	doneDrainingChan chan struct{}
+	drainChan   chan chan struct{} // each drain request brings its own channel, closed once the worker is drained
+	stoppedChan chan struct{}      // closed once the worker stops, so that drains don't wait for it, and then replaced. Guarded by mtx.
+
+	// others are the worker's counterparts in the pool's other namespaces, see WorkerPoolOptions.Namespaces. They
+	// aren't started: the worker fetches jobs from each of them in turn, and has them process the jobs they fetched.
+	others        []*worker
+	nextNamespace int // the namespace the worker fetches from first next time, only used by loop
 }
 
 func newWorker(namespace string, poolID string, pool *redis.Pool, contextType reflect.Type, middleware []*middlewareHandler, jobTypes map[string]*jobType, sleepBackoffs []int64) *worker {
@@ -53,8 +80,8 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 
-		drainChan:        make(chan struct{}),
-		doneDrainingChan: make(chan struct{}),
+		drainChan:   make(chan chan struct{}),
+		stoppedChan: make(chan struct{}),
 	}
 
 	w.updateMiddlewareAndJobTypes(middleware, jobTypes)
@@ -62,46 +89,133 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 	return w
 }
 
-// note: can't be called while the thing is started
+// updateMiddlewareAndJobTypes can be called while the worker is started. jobTypes must not be modified afterwards.
 func (w *worker) updateMiddlewareAndJobTypes(middleware []*middlewareHandler, jobTypes map[string]*jobType) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
 	w.middleware = middleware
-	sampler := prioritySampler{}
+	sampler := prioritySampler{strict: w.sampler.strict}
 	for _, jt := range jobTypes {
+		for queue, priority := range w.queues {
+			namedQueue := redisKeyJobsQueue(w.namespace, jt.Name, queue)
+			sampler.add(priority,
+				namedQueue,
+				redisKeyJobsInProgress(w.namespace, w.poolID, jt.Name),
+				redisKeyJobsPaused(w.namespace, jt.Name),
+				redisKeyJobsLock(w.namespace, jt.Name),
+				redisKeyJobsLockInfo(w.namespace, jt.Name),
+				redisKeyJobsConcurrency(w.namespace, jt.Name),
+				redisKeyJobsRateLimit(w.namespace, jt.Name),
+				namedQueue+":groups", // jobs in a named queue never have a group
+				redisKeyJobsGroupLock(w.namespace, jt.Name),
+				redisKeyJobsGroupLockInfo(w.namespace, jt.Name),
+				redisKeyJobsGroupConcurrency(w.namespace, jt.Name),
+				redisKeyJobsGroupTurns(w.namespace, jt.Name))
+		}
+		if w.queuesOnly {
+			continue
+		}
+		for lane, priority := range jt.Lanes {
+			laneQueue := redisKeyJobsLane(w.namespace, jt.Name, lane)
+			sampler.add(priority,
+				laneQueue,
+				redisKeyJobsInProgress(w.namespace, w.poolID, jt.Name),
+				redisKeyJobsPaused(w.namespace, jt.Name),
+				redisKeyJobsLock(w.namespace, jt.Name),
+				redisKeyJobsLockInfo(w.namespace, jt.Name),
+				redisKeyJobsConcurrency(w.namespace, jt.Name),
+				redisKeyJobsRateLimit(w.namespace, jt.Name),
+				laneQueue+":groups", // jobs in a lane never have a group
+				redisKeyJobsGroupLock(w.namespace, jt.Name),
+				redisKeyJobsGroupLockInfo(w.namespace, jt.Name),
+				redisKeyJobsGroupConcurrency(w.namespace, jt.Name),
+				redisKeyJobsGroupTurns(w.namespace, jt.Name))
+		}
 		sampler.add(jt.Priority,
 			redisKeyJobs(w.namespace, jt.Name),
 			redisKeyJobsInProgress(w.namespace, w.poolID, jt.Name),
 			redisKeyJobsPaused(w.namespace, jt.Name),
 			redisKeyJobsLock(w.namespace, jt.Name),
 			redisKeyJobsLockInfo(w.namespace, jt.Name),
-			redisKeyJobsConcurrency(w.namespace, jt.Name))
+			redisKeyJobsConcurrency(w.namespace, jt.Name),
+			redisKeyJobsRateLimit(w.namespace, jt.Name),
+			redisKeyJobsGroups(w.namespace, jt.Name),
+			redisKeyJobsGroupLock(w.namespace, jt.Name),
+			redisKeyJobsGroupLockInfo(w.namespace, jt.Name),
+			redisKeyJobsGroupConcurrency(w.namespace, jt.Name),
+			redisKeyJobsGroupTurns(w.namespace, jt.Name))
 	}
 	w.sampler = sampler
 	w.jobTypes = jobTypes
-	w.redisFetchScript = redis.NewScript(len(jobTypes)*fetchKeysPerJobType, redisLuaFetchJob)
+	w.redisFetchScript = redis.NewScript(len(sampler.samples)*fetchKeysPerJobType, redisLuaFetchJob)
 }
 
 func (w *worker) start() {
+	atomic.StoreInt32(&w.stopping, 0)
+	atomic.StoreInt64(&w.lastFetchAt, time.Now().UnixNano())
 	go w.loop()
-	go w.observer.start()
+	for _, nw := range w.all() {
+		go nw.observer.start()
+	}
 }
 
 func (w *worker) stop() {
+	atomic.StoreInt32(&w.stopping, 1)
 	w.stopChan <- struct{}{}
 	<-w.doneStoppingChan
-	w.observer.drain()
-	w.observer.stop()
+	w.mtx.Lock()
+	close(w.stoppedChan)
+	w.stoppedChan = make(chan struct{})
+	w.mtx.Unlock()
+	for _, nw := range w.all() {
+		nw.observer.drain()
+		nw.observer.stop()
+	}
+}
+
+// all returns the worker followed by its counterparts in the pool's other namespaces.
+func (w *worker) all() []*worker {
+	return append([]*worker{w}, w.others...)
 }
 
 func (w *worker) drain() {
-	w.drainChan <- struct{}{}
-	<-w.doneDrainingChan
-	w.observer.drain()
+	w.drainContext(context.Background())
+}
+
+// drainContext waits until the worker finds no job to fetch, stops, or until ctx is done.
+func (w *worker) drainContext(ctx context.Context) error {
+	w.mtx.Lock()
+	stopped := w.stoppedChan
+	w.mtx.Unlock()
+
+	done := make(chan struct{})
+	select {
+	case w.drainChan <- done:
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-done:
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for _, nw := range w.all() {
+		nw.observer.drain()
+	}
+	return nil
 }
 
 var sleepBackoffsInMilliseconds = []int64{0, 10, 100, 1000, 5000}
 
 func (w *worker) loop() {
-	var drained bool
+	var drained []chan struct{}
 	var consequtiveNoJobs int64
 
 	// Begin immediately. We'll change the duration on each tick with a timer.Reset()
@@ -113,23 +227,34 @@ func (w *worker) loop() {
 		case <-w.stopChan:
 			w.doneStoppingChan <- struct{}{}
 			return
-		case <-w.drainChan:
-			drained = true
+		case done := <-w.drainChan:
+			drained = append(drained, done)
 			timer.Reset(0)
 		case <-timer.C:
-			job, err := w.fetchJob()
+			if atomic.LoadInt32(&w.stopping) == 1 {
+				continue // wait for stopChan
+			}
+			var job *Job
+			var from *worker
+			var err error
+			if atomic.LoadInt32(&w.quiet) == 0 {
+				job, from, err = w.fetchJobFromNamespaces()
+			}
 			if err != nil {
 				logError("worker.fetch", err)
 				timer.Reset(10 * time.Millisecond)
 			} else if job != nil {
-				w.processJob(job)
+				atomic.StoreInt64(&w.busySince, time.Now().UnixNano())
+				from.startJob(job)
+				from.processJob(job)
+				w.markIdle()
 				consequtiveNoJobs = 0
 				timer.Reset(0)
 			} else {
-				if drained {
-					w.doneDrainingChan <- struct{}{}
-					drained = false
+				for _, done := range drained {
+					close(done)
 				}
+				drained = nil
 				consequtiveNoJobs++
 				idx := consequtiveNoJobs
 				if idx >= int64(len(w.sleepBackoffs)) {
@@ -141,25 +266,161 @@ func (w *worker) loop() {
 	}
 }
 
+// setQueues changes the named queues the worker fetches from, and whether it only fetches from them.
+func (w *worker) setQueues(queues map[string]uint, queuesOnly bool) {
+	w.mtx.Lock()
+	w.queues = queues
+	w.queuesOnly = queuesOnly
+	middleware, jobTypes := w.middleware, w.jobTypes
+	w.mtx.Unlock()
+
+	w.updateMiddlewareAndJobTypes(middleware, jobTypes)
+}
+
+// setQuiet stops or resumes fetching jobs. A quiet worker backs off as if it found no job, and is drained.
+func (w *worker) setQuiet(quiet bool) {
+	var v int32
+	if quiet {
+		v = 1
+	}
+	atomic.StoreInt32(&w.quiet, v)
+}
+
+func (w *worker) startJob(job *Job) {
+	ctx, cancel := context.WithCancel(context.Background())
+	job.ctx = ctx
+
+	w.jobMtx.Lock()
+	defer w.jobMtx.Unlock()
+	w.currentJob = job
+	w.cancelJob = cancel
+	w.abandoned = false
+}
+
+// finishJob returns false if the current job was handed back to its queue already, and shouldn't be terminated.
+func (w *worker) finishJob() bool {
+	w.jobMtx.Lock()
+	defer w.jobMtx.Unlock()
+
+	if w.currentJob == nil {
+		return true
+	}
+	abandoned := w.abandoned
+	w.cancelJob()
+	w.currentJob = nil
+	w.cancelJob = nil
+	w.abandoned = false
+	return !abandoned
+}
+
+// abandonJob cancels the current job and returns it, unless there's none. The worker won't terminate the job when it
+// returns, so the caller has to.
+func (w *worker) abandonJob() *Job {
+	w.jobMtx.Lock()
+	defer w.jobMtx.Unlock()
+
+	if w.currentJob == nil || w.abandoned {
+		return nil
+	}
+	w.abandoned = true
+	w.cancelJob()
+	return w.currentJob
+}
+
+// handBackJob pushes an abandoned job back onto its queue.
+func (w *worker) handBackJob(job *Job) {
+	w.terminateJob(job, terminateAndRequeue(w, job))
+}
+
+func (w *worker) markIdle() {
+	if since := atomic.SwapInt64(&w.busySince, 0); since != 0 {
+		atomic.AddInt64(&w.busyTotal, time.Now().UnixNano()-since)
+	}
+}
+
+// busyTime returns how long the worker has spent processing jobs, including the one it's processing now.
+func (w *worker) busyTime(now time.Time) time.Duration {
+	busy := atomic.LoadInt64(&w.busyTotal)
+	if since := atomic.LoadInt64(&w.busySince); since != 0 {
+		busy += now.UnixNano() - since
+	}
+	return time.Duration(busy)
+}
+
+// lastFetch returns when the worker last asked redis for a job successfully, or when it started if it hasn't yet. It's
+// zero if the worker was never started.
+func (w *worker) lastFetch() time.Time {
+	var at int64
+	for _, nw := range w.all() {
+		if nwAt := atomic.LoadInt64(&nw.lastFetchAt); nwAt > at {
+			at = nwAt
+		}
+	}
+	if at == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, at)
+}
+
+// maxSleepBackoff returns the longest the worker waits between fetches while it finds no job.
+func (w *worker) maxSleepBackoff() time.Duration {
+	var max int64
+	for _, backoff := range w.sleepBackoffs {
+		if backoff > max {
+			max = backoff
+		}
+	}
+	return time.Duration(max) * time.Millisecond
+}
+
+// fetchJobFromNamespaces fetches a job from the first of the worker's namespaces that has one, and returns the worker
+// of that namespace along with it. Each fetch starts with the next namespace, so that a busy namespace can't starve
+// the others.
+func (w *worker) fetchJobFromNamespaces() (*Job, *worker, error) {
+	if len(w.others) == 0 {
+		job, err := w.fetchJob()
+		return job, w, err
+	}
+
+	all := w.all()
+	first := w.nextNamespace
+	w.nextNamespace = (first + 1) % len(all)
+	for i := range all {
+		nw := all[(first+i)%len(all)]
+		job, err := nw.fetchJob()
+		if err != nil || job != nil {
+			return job, nw, err
+		}
+	}
+	return nil, w, nil
+}
+
 func (w *worker) fetchJob() (*Job, error) {
+	w.mtx.Lock()
 	// resort queues
 	// NOTE: we could optimize this to only resort every second, or something.
 	w.sampler.sample()
 	numKeys := len(w.sampler.samples) * fetchKeysPerJobType
-	var scriptArgs = make([]interface{}, 0, numKeys+1)
+	var scriptArgs = make([]interface{}, 0, numKeys+2)
 
 	for _, s := range w.sampler.samples {
-		scriptArgs = append(scriptArgs, s.redisJobs, s.redisJobsInProg, s.redisJobsPaused, s.redisJobsLock, s.redisJobsLockInfo, s.redisJobsMaxConcurrency) // KEYS[1-6 * N]
+		scriptArgs = append(scriptArgs, s.redisJobs, s.redisJobsInProg, s.redisJobsPaused, s.redisJobsLock, s.redisJobsLockInfo, s.redisJobsMaxConcurrency, s.redisJobsRateLimit) // KEYS[1-7 * N]
+		scriptArgs = append(scriptArgs, s.redisJobsGroups, s.redisJobsGroupLock, s.redisJobsGroupLockInfo, s.redisJobsMaxGroupConcurrency, s.redisJobsGroupTurns)                 // KEYS[8-12 * N]
 	}
-	scriptArgs = append(scriptArgs, w.poolID) // ARGV[1]
+	scriptArgs = append(scriptArgs, w.poolID, nowEpochMilliseconds()) // ARGV[1-2]
+	script := w.redisFetchScript
+	w.mtx.Unlock()
+
 	conn := w.pool.Get()
 	defer conn.Close()
 
-	values, err := redis.Values(w.redisFetchScript.Do(conn, scriptArgs...))
+	values, err := redis.Values(script.Do(conn, scriptArgs...))
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+	atomic.StoreInt64(&w.lastFetchAt, time.Now().UnixNano())
 	if err == redis.ErrNil {
 		return nil, nil
-	} else if err != nil {
-		return nil, err
 	}
 
 	if len(values) != 3 {
@@ -190,24 +451,46 @@ func (w *worker) fetchJob() (*Job, error) {
 }
 
 func (w *worker) processJob(job *Job) {
-	if job.Unique {
-		updatedJob := w.getAndDeleteUniqueJob(job)
+	w.mtx.Lock()
+	jt := w.jobTypes[job.Name]
+	middleware := w.middleware
+	w.mtx.Unlock()
+	uniqueMode := job.UniqueMode
+	if uniqueMode == "" && jt != nil {
+		uniqueMode = jt.UniqueMode
+	}
+
+	if job.Unique && uniqueMode != UniqueWhileExecuting {
+		updatedJob := w.getAndDeleteUniqueJob(job, uniqueMode != UniqueUntilComplete)
 		// This is to support the old way of doing it, where we used the job off the queue and just deleted the unique key
 		// Going forward the job on the queue will always be just a placeholder, and we will be replacing it with the
 		// updated job extracted here
 		if updatedJob != nil {
+			updatedJob.ctx = job.ctx
 			job = updatedJob
 		}
 	}
 	var runErr error
-	jt := w.jobTypes[job.Name]
 	if jt == nil {
 		runErr = fmt.Errorf("stray job: no handler")
 		logError("process_job.stray", runErr)
 	} else {
+		if jt.NoOverlap || (job.Unique && uniqueMode == UniqueWhileExecuting) {
+			lock, err := acquireNoOverlapLock(w.pool, noOverlapLockKey(w.namespace, jt, job, uniqueMode), jt.NoOverlapLease)
+			if err != nil {
+				// Rather run the job than lose it if redis is having trouble.
+				logError("process_job.no_overlap", err)
+			} else if lock == nil {
+				w.removeJobFromInProgress(job, w.releaseUniqueUntilComplete(job, uniqueMode, terminateOverlapping(w, jt, job, uniqueMode)))
+				return
+			} else {
+				defer lock.release()
+			}
+		}
+
 		w.observeStarted(job.Name, job.ID, job.Args)
 		job.observer = w.observer // for Checkin
-		_, runErr = runJob(job, w.contextType, w.middleware, jt)
+		_, runErr = runJob(job, w.contextType, middleware, jt)
 		w.observeDone(job.Name, job.ID, runErr)
 	}
 
@@ -216,21 +499,41 @@ func (w *worker) processJob(job *Job) {
 		job.failed(runErr)
 		fate = w.jobFate(jt, job)
 	}
-	w.removeJobFromInProgress(job, fate)
+	w.removeJobFromInProgress(job, w.releaseUniqueUntilComplete(job, uniqueMode, fate))
 }
 
-func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
-	var uniqueKey string
-	var err error
+// releaseUniqueUntilComplete deletes the unique key of a job with UniqueUntilComplete along with terminating it.
+func (w *worker) releaseUniqueUntilComplete(job *Job, uniqueMode UniqueMode, fate terminateOp) terminateOp {
+	if !job.Unique || uniqueMode != UniqueUntilComplete {
+		return fate
+	}
+
+	uniqueKey, err := w.uniqueKey(job)
+	if err != nil {
+		logError("worker.release_unique.key", err)
+		return fate
+	}
 
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("DEL", uniqueKey)
+	}
+}
+
+func (w *worker) uniqueKey(job *Job) (string, error) {
 	if job.UniqueKey != "" {
-		uniqueKey = job.UniqueKey
-	} else { // For jobs put in queue prior to this change. In the future this can be deleted as there will always be a UniqueKey
-		uniqueKey, err = redisKeyUniqueJob(w.namespace, job.Name, job.Args)
-		if err != nil {
-			logError("worker.delete_unique_job.key", err)
-			return nil
-		}
+		return job.UniqueKey, nil
+	}
+	// For jobs put in queue prior to UniqueKey. In the future this can be deleted as there will always be a UniqueKey
+	return redisKeyUniqueJob(w.namespace, job.Name, job.Args)
+}
+
+// getAndDeleteUniqueJob returns the job stored in the unique key of job, deleting the key unless del is false.
+func (w *worker) getAndDeleteUniqueJob(job *Job, del bool) *Job {
+	uniqueKey, err := w.uniqueKey(job)
+	if err != nil {
+		logError("worker.delete_unique_job.key", err)
+		return nil
 	}
 
 	conn := w.pool.Get()
@@ -242,10 +545,12 @@ func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
 		return nil
 	}
 
-	_, err = conn.Do("DEL", uniqueKey)
-	if err != nil {
-		logError("worker.delete_unique_job.del", err)
-		return nil
+	if del {
+		_, err = conn.Do("DEL", uniqueKey)
+		if err != nil {
+			logError("worker.delete_unique_job.del", err)
+			return nil
+		}
 	}
 
 	// Previous versions did not support updated arguments and just set key to 1, so in these cases we should do nothing.
@@ -265,6 +570,13 @@ func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
 }
 
 func (w *worker) removeJobFromInProgress(job *Job, fate terminateOp) {
+	if !w.finishJob() {
+		return
+	}
+	w.terminateJob(job, fate)
+}
+
+func (w *worker) terminateJob(job *Job, fate terminateOp) {
 	conn := w.pool.Get()
 	defer conn.Close()
 
@@ -272,6 +584,9 @@ func (w *worker) removeJobFromInProgress(job *Job, fate terminateOp) {
 	conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
 	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
 	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
+	if job.Group != "" {
+		conn.Send("EVAL", redisLuaReleaseGroupLockCmd, 2, redisKeyJobsGroupLock(w.namespace, job.Name), redisKeyJobsGroupLockInfo(w.namespace, job.Name), w.poolID, job.Group)
+	}
 	fate(conn)
 	if _, err := conn.Do("EXEC"); err != nil {
 		logError("worker.remove_job_from_in_progress.lrem", err)
@@ -291,6 +606,11 @@ func terminateAndRetry(w *worker, jt *jobType, job *Job) terminateOp {
 		conn.Send("ZADD", redisKeyRetry(w.namespace), nowEpochSeconds()+jt.calcBackoff(job), rawJSON)
 	}
 }
+func terminateAndRequeue(w *worker, job *Job) terminateOp {
+	return func(conn redis.Conn) {
+		conn.Send("EVAL", redisLuaEnqueuePushJob, 1, redisKeyJobs(w.namespace, job.Name), job.rawJSON, job.Group, job.Lane, job.Queue)
+	}
+}
 func terminateAndDead(w *worker, job *Job) terminateOp {
 	rawJSON, err := job.serialize()
 	if err != nil {
@@ -298,12 +618,49 @@ func terminateAndDead(w *worker, job *Job) terminateOp {
 		return terminateOnly
 	}
 	return func(conn redis.Conn) {
-		// NOTE: sidekiq limits the # of jobs: only keep jobs for 6 months, and only keep a max # of jobs
-		// The max # of jobs seems really horrible. Seems like operations should be on top of it.
-		// conn.Send("ZREMRANGEBYSCORE", redisKeyDead(w.namespace), "-inf", now - keepInterval)
-		// conn.Send("ZREMRANGEBYRANK", redisKeyDead(w.namespace), 0, -maxJobs)
+		now := nowEpochSeconds()
+		conn.Send("ZADD", redisKeyDead(w.namespace), now, rawJSON)
+
+		// Like sidekiq, optionally only keep dead jobs for so long, and only keep a max # of them.
+		retention := w.deadJobRetention
+		if retention.MaxAge > 0 || retention.MaxCount > 0 {
+			var keepSince int64
+			if retention.MaxAge > 0 {
+				keepSince = now - int64(retention.MaxAge/time.Second)
+			}
+			conn.Send("EVAL", redisLuaTrimDeadCmd, 2, redisKeyDead(w.namespace), redisKeyStats(w.namespace), keepSince, retention.MaxCount)
+		}
+	}
+}
+
+// terminateOverlapping skips or delays a job that can't run because its no-overlap lock is held. Jobs with
+// UniqueWhileExecuting are always delayed.
+func terminateOverlapping(w *worker, jt *jobType, job *Job, uniqueMode UniqueMode) terminateOp {
+	delay := jt.NoOverlapDelay
+	whileExecuting := job.Unique && uniqueMode == UniqueWhileExecuting
+	if whileExecuting && delay <= 0 {
+		delay = defaultWhileExecutingDelay
+	}
 
-		conn.Send("ZADD", redisKeyDead(w.namespace), nowEpochSeconds(), rawJSON)
+	if delay <= 0 {
+		return func(conn redis.Conn) {
+			conn.Send("HINCRBY", redisKeyStats(w.namespace), "no_overlap_skipped", 1)
+		}
+	}
+
+	// The unique key, if any, is released by now unless the job is only unique while executing.
+	delayed := *job
+	delayed.Unique = whileExecuting
+	rawJSON, err := delayed.serialize()
+	if err != nil {
+		logError("worker.terminate_overlapping.serialize", err)
+		return terminateOnly
+	}
+	// The scheduled set is scored in seconds, so round up, or a sub-second delay would reschedule the job right away.
+	delaySecs := int64((delay + time.Second - 1) / time.Second)
+	return func(conn redis.Conn) {
+		conn.Send("ZADD", redisKeyScheduled(w.namespace), nowEpochSeconds()+delaySecs, rawJSON)
+		conn.Send("HINCRBY", redisKeyStats(w.namespace), "no_overlap_delayed", 1)
 	}
 }
 
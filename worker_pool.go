@@ -1,22 +1,32 @@
 package work
 
 import (
+	"context"
+	"fmt"
 	"reflect"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
-	"github.com/robfig/cron"
 )
 
 // WorkerPool represents a pool of workers. It forms the primary API of gocraft/work. WorkerPools provide the public API of gocraft/work. You can attach jobs and middlware to them. You can start and stop them. Based on their concurrency setting, they'll spin up N worker goroutines.
 type WorkerPool struct {
-	workerPoolID  string
-	concurrency   uint
-	namespace     string // eg, "myapp-work"
-	pool          *redis.Pool
-	sleepBackoffs []int64
+	workerPoolID     string
+	concurrency      uint
+	namespace        string // eg, "myapp-work"
+	pool             *redis.Pool
+	sleepBackoffs    []int64
+	deadJobRetention DeadJobRetention
+
+	strictPriority bool
+	autoscale      AutoscaleOptions
+	janitor        JanitorOptions
+	labels         map[string]string
+	queues         map[string]uint
+	queuesOnly     bool
 
 	contextType  reflect.Type
 	jobTypes     map[string]*jobType
@@ -24,7 +34,30 @@ type WorkerPool struct {
 	started      bool
 	periodicJobs []*periodicJob
 
-	workers          []*worker
+	// workersMtx guards what can change while the pool is started: the concurrency and workers (see SetConcurrency),
+	// the job types and middleware. jobTypes is replaced rather than modified, since workers share it.
+	workersMtx sync.Mutex
+	// startStopMtx keeps Start from running while Stop waits for the workers, which it does without holding workersMtx.
+	startStopMtx sync.Mutex
+
+	quiet      bool // see Quiet
+	workers    []*worker
+	autoscaler *autoscaler
+	namespaces []*poolNamespace // namespace first, then WorkerPoolOptions.Namespaces
+
+	// A copy of the state reported by Status, which can't wait for workersMtx since Start and SetConcurrency hold it
+	// while they talk to redis. See updateStatus.
+	statusMtx          sync.Mutex
+	statusStarted      bool
+	statusQuiet        bool
+	statusDraining     int // the number of drains in progress
+	statusWorkers      []*worker
+	statusHeartbeaters []*workerPoolHeartbeater
+}
+
+// poolNamespace holds what a started pool runs for each of its namespaces, besides the workers.
+type poolNamespace struct {
+	namespace        string
 	heartbeater      *workerPoolHeartbeater
 	retrier          *requeuer
 	scheduler        *requeuer
@@ -61,11 +94,78 @@ type JobOptions struct {
 	SkipDead       bool              // If true, don't send failed jobs to the dead queue when retries are exhausted.
 	MaxConcurrency uint              // Max number of jobs to keep in flight (default is 0, meaning no max)
 	Backoff        BackoffCalculator // If not set, uses the default backoff algorithm
+
+	MaxGroupConcurrency uint // Max number of jobs of each group (see EnqueueForGroup) to keep in flight (default is 0, meaning no max)
+
+	Lanes map[string]uint // Extra queues with their own priority that jobs can be enqueued to with EnqueueToLane, eg {"high": 100}
+
+	NoOverlap      bool          // If true, a job doesn't run while another one of its type is running anywhere. It's skipped unless NoOverlapDelay is set.
+	NoOverlapByKey bool          // If true, only jobs with the same unique key (see EnqueueUniqueByKey) can't overlap. Jobs without one fall back to the job name.
+	NoOverlapLease time.Duration // How long the no-overlap lock outlives a worker pool that died while running the job. Defaults to 1 minute, and can't be less than a second.
+	NoOverlapDelay time.Duration // If set, a job that would overlap is rescheduled this far in the future instead of being skipped, rounded up to whole seconds.
+
+	UniqueMode UniqueMode    // How long unique jobs of this type keep duplicates out, see EnqueueUnique. Defaults to UniqueUntilStart.
+	UniqueTTL  time.Duration // How long unique jobs of this type are unique for at most. Defaults to 24 hours.
+
+	RateLimit RateLimit // If set, limits how many jobs of this type start per period across all worker pools.
+}
+
+// RateLimit is a token bucket shared by all worker pools: it holds up to Burst tokens, which are refilled at Limit
+// tokens per Per, and each job takes one when it starts. Jobs stay queued while the bucket is empty.
+type RateLimit struct {
+	Limit uint          // How many jobs can start per Per. 0 means no limit.
+	Per   time.Duration // eg time.Second
+	Burst uint          // How many jobs can start at once after a quiet period. Defaults to Limit.
 }
 
 // WorkerPoolOptions can be passed to NewWorkerPoolWithOptions.
 type WorkerPoolOptions struct {
-	SleepBackoffs []int64 // Sleep backoffs in milliseconds
+	SleepBackoffs    []int64           // Sleep backoffs in milliseconds
+	DeadJobRetention DeadJobRetention  // If set, limits how many jobs are kept in the dead queue
+	StrictPriority   bool              // If true, workers always fetch from the queue with the highest priority that has jobs, instead of picking queues at random weighted by priority
+	Autoscale        AutoscaleOptions  // If set, the pool scales its concurrency between a min and a max while started
+	Janitor          JanitorOptions    // If enabled, the pool cleans up stale keys when it starts
+	Labels           map[string]string // Reported in the pool's heartbeat, eg {"version": "1.4.2", "region": "eu-west-1"}
+	Queues           map[string]uint   // Named queues to fetch jobs from as well, with their priority from 1 to 100000, see EnqueueTo
+	QueuesOnly       bool              // If true, the pool only fetches jobs from Queues, not from the job types' own queues and lanes
+	Namespaces       []string          // Other namespaces the pool serves with the same jobs and middleware. Workers fetch from each namespace in turn.
+}
+
+// DeadJobRetention limits the size of the dead queue. Whenever a job dies, dead jobs older than MaxAge are trimmed,
+// then the oldest dead jobs beyond MaxCount. A zero value means no limit. The number of trimmed jobs is reported by Client.Stats.
+type DeadJobRetention struct {
+	MaxAge   time.Duration
+	MaxCount uint
+}
+
+// PeriodicJobOptions can be passed to PeriodicallyEnqueueWithOptions.
+type PeriodicJobOptions struct {
+	Location    *time.Location // The time zone the spec is evaluated in. Defaults to the local time zone. A CRON_TZ= prefix in the spec takes precedence.
+	Misfire     MisfirePolicy  // What to do about firings missed while no worker pool was running. Defaults to MisfireSkip.
+	MaxMisfires uint           // The most missed firings MisfireRunAll enqueues. Defaults to 100.
+}
+
+// MisfirePolicy decides what happens to the firings of a periodic job that were missed because no worker pool was
+// enqueueing periodic jobs at the time, eg during a deploy or an outage.
+type MisfirePolicy string
+
+const (
+	// MisfireSkip drops missed firings.
+	MisfireSkip MisfirePolicy = "skip"
+	// MisfireRunOnce enqueues a single job for the most recent missed firing.
+	MisfireRunOnce MisfirePolicy = "run_once"
+	// MisfireRunAll enqueues a job for each missed firing, up to the most recent MaxMisfires of them.
+	MisfireRunAll MisfirePolicy = "run_all"
+)
+
+const defaultMaxMisfires = 100
+
+func (p MisfirePolicy) validate() error {
+	switch p {
+	case "", MisfireSkip, MisfireRunOnce, MisfireRunAll:
+		return nil
+	}
+	return fmt.Errorf("work: unknown misfire policy %q", string(p))
 }
 
 // GenericHandler is a job handler without any custom context.
@@ -99,23 +199,112 @@ func NewWorkerPoolWithOptions(ctx interface{}, concurrency uint, namespace strin
 	ctxType := reflect.TypeOf(ctx)
 	validateContextType(ctxType)
 	wp := &WorkerPool{
-		workerPoolID:  makeIdentifier(),
-		concurrency:   concurrency,
-		namespace:     namespace,
-		pool:          pool,
-		sleepBackoffs: workerPoolOpts.SleepBackoffs,
-		contextType:   ctxType,
-		jobTypes:      make(map[string]*jobType),
+		workerPoolID:     makeIdentifier(),
+		concurrency:      concurrency,
+		namespace:        namespace,
+		pool:             pool,
+		sleepBackoffs:    workerPoolOpts.SleepBackoffs,
+		deadJobRetention: workerPoolOpts.DeadJobRetention,
+		strictPriority:   workerPoolOpts.StrictPriority,
+		autoscale:        workerPoolOpts.Autoscale,
+		janitor:          workerPoolOpts.Janitor,
+		labels:           workerPoolOpts.Labels,
+		queues:           workerPoolOpts.Queues,
+		queuesOnly:       workerPoolOpts.QueuesOnly,
+		contextType:      ctxType,
+		jobTypes:         make(map[string]*jobType),
+	}
+
+	if err := wp.autoscale.validate(); err != nil {
+		panic(err)
+	}
+	for queue, priority := range wp.queues {
+		if queue == "" || priority == 0 || priority > 100000 {
+			panic("work: WorkerPoolOptions.Queues needs queue names and priorities between 1 and 100000")
+		}
+	}
+	if wp.queuesOnly && len(wp.queues) == 0 {
+		panic("work: WorkerPoolOptions.QueuesOnly needs Queues")
+	}
+	wp.namespaces = append(wp.namespaces, &poolNamespace{namespace: namespace})
+	for _, ns := range workerPoolOpts.Namespaces {
+		for _, seen := range wp.namespaces {
+			if ns == seen.namespace {
+				panic(fmt.Sprintf("work: WorkerPoolOptions.Namespaces lists the namespace %q twice", ns))
+			}
+		}
+		wp.namespaces = append(wp.namespaces, &poolNamespace{namespace: ns})
 	}
 
 	for i := uint(0); i < wp.concurrency; i++ {
-		w := newWorker(wp.namespace, wp.workerPoolID, wp.pool, wp.contextType, nil, wp.jobTypes, wp.sleepBackoffs)
-		wp.workers = append(wp.workers, w)
+		wp.workers = append(wp.workers, wp.newWorker())
 	}
+	wp.updateStatus()
 
 	return wp
 }
 
+// newWorker returns a worker for the first namespace, with its counterparts in the other namespaces.
+func (wp *WorkerPool) newWorker() *worker {
+	w := wp.newNamespaceWorker(wp.namespace)
+	w.setQuiet(wp.quiet)
+	for _, ns := range wp.namespaces[1:] {
+		w.others = append(w.others, wp.newNamespaceWorker(ns.namespace))
+	}
+	return w
+}
+
+func (wp *WorkerPool) newNamespaceWorker(namespace string) *worker {
+	w := newWorker(namespace, wp.workerPoolID, wp.pool, wp.contextType, wp.middleware, wp.jobTypes, wp.sleepBackoffs)
+	w.deadJobRetention = wp.deadJobRetention
+	w.sampler.strict = wp.strictPriority
+	if len(wp.queues) > 0 {
+		w.setQueues(wp.queues, wp.queuesOnly)
+	}
+	return w
+}
+
+// SetConcurrency changes the number of workers, and can be called while the pool is started. New workers start
+// fetching jobs right away. Removed workers finish the job they're processing first, which SetConcurrency waits for,
+// as well as for Drain to return.
+func (wp *WorkerPool) SetConcurrency(concurrency uint) {
+	wp.workersMtx.Lock()
+
+	var removed []*worker
+	for uint(len(wp.workers)) < concurrency {
+		w := wp.newWorker()
+		wp.workers = append(wp.workers, w)
+		if wp.started {
+			go w.start()
+		}
+	}
+	if uint(len(wp.workers)) > concurrency {
+		removed = append(removed, wp.workers[concurrency:]...)
+		wp.workers = wp.workers[:concurrency:concurrency]
+	}
+	wp.concurrency = concurrency
+	started := wp.started
+	if started {
+		for i, ns := range wp.namespaces {
+			ns.heartbeater.setWorkers(concurrency, wp.namespaceWorkerIDs(i))
+		}
+	}
+	wp.updateStatus()
+
+	wp.workersMtx.Unlock()
+
+	if started {
+		stopWorkers(removed)
+	}
+}
+
+// Concurrency returns the number of workers.
+func (wp *WorkerPool) Concurrency() uint {
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
+	return wp.concurrency
+}
+
 // Middleware appends the specified function to the middleware chain. The fn can take one of these forms:
 // (*ContextType).func(*Job, NextMiddlewareFunc) error, (ContextType matches the type of ctx specified when creating a pool)
 // func(*Job, NextMiddlewareFunc) error, for the generic middleware format.
@@ -132,10 +321,16 @@ func (wp *WorkerPool) Middleware(fn interface{}) *WorkerPool {
 		mw.GenericMiddlewareHandler = gmh
 	}
 
-	wp.middleware = append(wp.middleware, mw)
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
+
+	middleware := make([]*middlewareHandler, 0, len(wp.middleware)+1)
+	wp.middleware = append(append(middleware, wp.middleware...), mw)
 
 	for _, w := range wp.workers {
-		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
+		for _, nw := range w.all() {
+			nw.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
+		}
 	}
 
 	return wp
@@ -151,6 +346,7 @@ func (wp *WorkerPool) Job(name string, fn interface{}) *WorkerPool {
 
 // JobWithOptions adds a handler for 'name' jobs as per the Job function, but permits you specify additional options
 // such as a job's priority, retry count, and whether to send dead jobs to the dead job queue or trash them.
+// Job types can be added or replaced while the pool is started.
 func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interface{}) *WorkerPool {
 	jobOpts = applyDefaultsAndValidate(jobOpts)
 
@@ -166,139 +362,617 @@ func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interfa
 		jt.GenericHandler = gh
 	}
 
-	wp.jobTypes[name] = jt
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
 
-	for _, w := range wp.workers {
-		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
+	jobTypes := make(map[string]*jobType, len(wp.jobTypes)+1)
+	for k, v := range wp.jobTypes {
+		jobTypes[k] = v
 	}
+	jobTypes[name] = jt
+	wp.setJobTypes(jobTypes)
 
 	return wp
 }
 
+// RemoveJob stops the pool from processing 'name' jobs, and can be called while the pool is started. Jobs that are
+// being processed finish, and jobs left in the queue wait for a pool that has a handler for them. Retried and scheduled
+// jobs don't: once they're due, the pool moves them to the dead queue, since it requeues only the jobs it has handlers
+// for, unless another pool that has one requeues them first. They can be retried from there, eg with
+// Client.RetryDeadJob, once a pool handles them again.
+func (wp *WorkerPool) RemoveJob(name string) *WorkerPool {
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
+
+	if _, ok := wp.jobTypes[name]; !ok {
+		return wp
+	}
+
+	jobTypes := make(map[string]*jobType, len(wp.jobTypes))
+	for k, v := range wp.jobTypes {
+		if k != name {
+			jobTypes[k] = v
+		}
+	}
+	wp.setJobTypes(jobTypes)
+
+	return wp
+}
+
+// setJobTypes hands jobTypes to the workers, and if the pool is started, to redis and the associated processes.
+// Must be called with workersMtx held.
+func (wp *WorkerPool) setJobTypes(jobTypes map[string]*jobType) {
+	wp.jobTypes = jobTypes
+	for _, w := range wp.workers {
+		for _, nw := range w.all() {
+			nw.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
+		}
+	}
+
+	if !wp.started {
+		return
+	}
+
+	wp.writeConcurrencyControlsToRedis()
+	wp.writeUniqueOptionsToRedis()
+	wp.writeRateLimitsToRedis()
+	wp.writePrioritiesToRedis()
+	wp.writeKnownJobsToRedis(wp.jobTypes)
+
+	jobNames := wp.jobNames()
+	for _, ns := range wp.namespaces {
+		// Beat right away, so that the jobs of new types are requeued by the reaper if the pool dies before the next beat.
+		ns.heartbeater.setJobTypes(wp.jobTypes)
+		ns.heartbeater.heartbeat()
+
+		ns.retrier.setJobNames(jobNames)
+		ns.scheduler.setJobNames(jobNames)
+		ns.deadPoolReaper.setJobTypes(jobNames)
+	}
+}
+
 // PeriodicallyEnqueue will periodically enqueue jobName according to the cron-based spec.
 // The spec format is based on https://godoc.org/github.com/robfig/cron, which is a relatively standard cron format.
 // Note that the first value is the seconds!
+// The spec may be prefixed with a time zone, eg "CRON_TZ=America/New_York 0 0 9 * * *". Times skipped by a DST
+// transition fire right after the clock jumps forward, and times repeated when the clock falls back only fire once.
 // If you have multiple worker pools on different machines, they'll all coordinate and only enqueue your job once.
+// A pool with several namespaces enqueues the job in each of them.
 func (wp *WorkerPool) PeriodicallyEnqueue(spec string, jobName string) *WorkerPool {
-	schedule, err := cron.Parse(spec)
+	return wp.PeriodicallyEnqueueWithOptions(spec, jobName, nil, PeriodicJobOptions{})
+}
+
+// PeriodicallyEnqueueWithOptions periodically enqueues jobName with the given args as per PeriodicallyEnqueue, but permits
+// you to specify additional options. The same jobName can be scheduled several times with different args, eg once per region.
+func (wp *WorkerPool) PeriodicallyEnqueueWithOptions(spec string, jobName string, args map[string]interface{}, opts PeriodicJobOptions) *WorkerPool {
+	schedule, err := parsePeriodicSpec(spec, opts.Location)
 	if err != nil {
 		panic(err)
 	}
 
-	wp.periodicJobs = append(wp.periodicJobs, &periodicJob{jobName: jobName, spec: spec, schedule: schedule})
+	if err := opts.Misfire.validate(); err != nil {
+		panic(err)
+	}
+
+	argsHash, err := periodicArgsHash(args)
+	if err != nil {
+		panic(err)
+	}
+
+	wp.periodicJobs = append(wp.periodicJobs, &periodicJob{
+		jobName:     jobName,
+		spec:        spec,
+		schedule:    schedule,
+		args:        args,
+		argsHash:    argsHash,
+		misfire:     opts.Misfire,
+		maxMisfires: opts.MaxMisfires,
+	})
 
 	return wp
 }
 
 // Start starts the workers and associated processes.
 func (wp *WorkerPool) Start() {
+	wp.startStopMtx.Lock()
+	defer wp.startStopMtx.Unlock()
+
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
+
 	if wp.started {
 		return
 	}
 	wp.started = true
 
-	// TODO: we should cleanup stale keys on startup from previously registered jobs
 	wp.writeConcurrencyControlsToRedis()
-	go wp.writeKnownJobsToRedis()
+	wp.writeUniqueOptionsToRedis()
+	wp.writeRateLimitsToRedis()
+	wp.writePrioritiesToRedis()
+	go wp.writeKnownJobsToRedis(wp.jobTypes)
 
 	for _, w := range wp.workers {
 		go w.start()
 	}
 
-	wp.heartbeater = newWorkerPoolHeartbeater(wp.namespace, wp.pool, wp.workerPoolID, wp.jobTypes, wp.concurrency, wp.workerIDs())
-	wp.heartbeater.start()
-	wp.startRequeuers()
-	wp.periodicEnqueuer = newPeriodicEnqueuer(wp.namespace, wp.pool, wp.periodicJobs)
-	wp.periodicEnqueuer.start()
+	for i, ns := range wp.namespaces {
+		ns.heartbeater = newWorkerPoolHeartbeater(ns.namespace, wp.pool, wp.workerPoolID, wp.jobTypes, wp.concurrency, wp.namespaceWorkerIDs(i), wp.labels)
+		ns.heartbeater.setQuiet(wp.quiet)
+		ns.heartbeater.runCommands = wp.runPoolCommands
+		ns.heartbeater.start()
+		wp.startRequeuers(ns)
+		ns.periodicEnqueuer = newPeriodicEnqueuer(ns.namespace, wp.pool, wp.periodicJobs)
+		ns.periodicEnqueuer.start()
+		if wp.janitor.Enabled {
+			go wp.runJanitor(newJanitor(ns.namespace, wp.pool, wp.jobNames(), wp.namespaceWorkerIDs(i)))
+		}
+	}
+	if wp.autoscale.MaxConcurrency > 0 {
+		wp.autoscaler = newAutoscaler(wp, wp.autoscale)
+		wp.autoscaler.start()
+	}
+	wp.updateStatus()
 }
 
-// Stop stops the workers and associated processes.
+func (wp *WorkerPool) runJanitor(j *janitor) {
+	report, err := j.clean(wp.janitor.DryRun)
+	if err != nil {
+		logError("worker_pool.janitor", err)
+		return
+	}
+
+	if wp.janitor.Report != nil {
+		wp.janitor.Report(report)
+	}
+}
+
+// Stop stops the workers and associated processes. It waits for the jobs that are running to finish.
 func (wp *WorkerPool) Stop() {
+	wp.StopWithContext(context.Background())
+}
+
+// StopWithContext stops the workers and associated processes like Stop, but only waits for the jobs that are running
+// until ctx is done. It then cancels their Job.Context, pushes them back onto their queues right away so that other
+// pools can pick them up, and returns ctx.Err(). Handlers that don't return keep running in the background.
+func (wp *WorkerPool) StopWithContext(ctx context.Context) error {
+	wp.startStopMtx.Lock()
+	defer wp.startStopMtx.Unlock()
+
+	wp.workersMtx.Lock()
 	if !wp.started {
-		return
+		wp.workersMtx.Unlock()
+		return nil
+	}
+	autoscaler := wp.autoscaler
+	wp.workersMtx.Unlock()
+
+	// The autoscaler calls SetConcurrency, so it has to be stopped without holding the lock.
+	if autoscaler != nil {
+		autoscaler.stop()
 	}
+
+	// The workers are waited for without holding the lock either, so that SetConcurrency, Quiet and the like don't
+	// block until their jobs are done. Workers added meanwhile aren't started, and removed ones are stopped here.
+	wp.workersMtx.Lock()
 	wp.started = false
+	wp.autoscaler = nil
+	wp.updateStatus()
+	workers := append([]*worker(nil), wp.workers...)
+	wp.workersMtx.Unlock()
+
+	unstopped, err := stopWorkersWithContext(ctx, workers)
+	if len(unstopped) > 0 {
+		wp.replaceWorkers(unstopped)
+	}
+	for _, ns := range wp.namespaces {
+		ns.heartbeater.stop()
+		ns.retrier.stop()
+		ns.scheduler.stop()
+		ns.deadPoolReaper.stop()
+		ns.periodicEnqueuer.stop()
+	}
+	return err
+}
 
-	wg := sync.WaitGroup{}
-	for _, w := range wp.workers {
-		wg.Add(1)
-		go func(w *worker) {
+// stopWorkersWithContext stops workers until ctx is done, and then hands back the jobs of the ones that didn't stop in
+// time, which it returns.
+func stopWorkersWithContext(ctx context.Context, workers []*worker) ([]*worker, error) {
+	stopped := make([]chan struct{}, len(workers))
+	for i, w := range workers {
+		stopped[i] = make(chan struct{})
+		go func(w *worker, stopped chan struct{}) {
 			w.stop()
-			wg.Done()
-		}(w)
+			close(stopped)
+		}(w, stopped[i])
 	}
-	wg.Wait()
-	wp.heartbeater.stop()
-	wp.retrier.stop()
-	wp.scheduler.stop()
-	wp.deadPoolReaper.stop()
-	wp.periodicEnqueuer.stop()
+
+	for _, s := range stopped {
+		select {
+		case <-s:
+		case <-ctx.Done():
+			return handBackJobs(workers, stopped), ctx.Err()
+		}
+	}
+	return nil, nil
+}
+
+func handBackJobs(workers []*worker, stopped []chan struct{}) []*worker {
+	var unstopped []*worker
+	for i, w := range workers {
+		select {
+		case <-stopped[i]:
+			continue
+		default:
+		}
+		for _, nw := range w.all() {
+			if job := nw.abandonJob(); job != nil {
+				nw.handBackJob(job)
+			}
+		}
+		unstopped = append(unstopped, w)
+	}
+	return unstopped
+}
+
+// replaceWorkers replaces the workers that didn't stop in time with new ones, so that the pool can be started again.
+// Workers removed by SetConcurrency in the meantime are gone already.
+func (wp *WorkerPool) replaceWorkers(unstopped []*worker) {
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
+
+	for _, u := range unstopped {
+		for i, w := range wp.workers {
+			if w == u {
+				wp.workers[i] = wp.newWorker()
+			}
+		}
+	}
+	wp.updateStatus()
 }
 
 // Drain drains all jobs in the queue before returning. Note that if jobs are added faster than we can process them, this function wouldn't return.
+// The pool isn't autoscaled while it drains, and workers added meanwhile by SetConcurrency aren't waited for.
 func (wp *WorkerPool) Drain() {
+	wp.DrainContext(context.Background())
+}
+
+// DrainContext drains all jobs in the queue like Drain, but gives up when ctx is done and returns ctx.Err().
+func (wp *WorkerPool) DrainContext(ctx context.Context) error {
+	wp.setDraining(1)
+	defer wp.setDraining(-1)
+
+	// Workers removed by SetConcurrency or Stop while the pool drains don't hold the drain up.
+	workers := wp.currentWorkers()
+	errs := make(chan error, len(workers))
+	for _, w := range workers {
+		go func(w *worker) {
+			errs <- w.drainContext(ctx)
+		}(w)
+	}
+
+	var err error
+	for range workers {
+		if e := <-errs; e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+const drainJobsPollPeriod = 10 * time.Millisecond
+
+// DrainJobs waits until the queues of the given job names, including their lanes, named queues and groups, and the
+// in-progress lists of all worker pools for them are empty in each of the pool's namespaces. Unlike Drain, it also
+// waits for paused jobs, and for jobs processed by other pools.
+func (wp *WorkerPool) DrainJobs(jobNames ...string) {
+	wp.DrainJobsContext(context.Background(), jobNames...)
+}
+
+// DrainJobsContext waits like DrainJobs, but gives up when ctx is done and returns ctx.Err().
+func (wp *WorkerPool) DrainJobsContext(ctx context.Context, jobNames ...string) error {
+	ticker := time.NewTicker(drainJobsPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		pending, err := wp.jobsPendingInNamespaces(jobNames)
+		if err != nil {
+			logError("worker_pool.drain_jobs", err)
+		} else if !pending {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (wp *WorkerPool) jobsPendingInNamespaces(jobNames []string) (bool, error) {
+	for _, ns := range wp.namespaces {
+		if pending, err := wp.jobsPending(ns.namespace, jobNames); err != nil || pending {
+			return pending, err
+		}
+	}
+	return false, nil
+}
+
+// jobsPending returns true if any of the job names has jobs queued or in progress in the namespace.
+func (wp *WorkerPool) jobsPending(namespace string, jobNames []string) (bool, error) {
+	conn := wp.pool.Get()
+	defer conn.Close()
+
+	poolIDs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyWorkerPools(namespace)))
+	if err != nil {
+		return false, err
+	}
+	// This pool might not have beat yet.
+	poolIDs = append(poolIDs, wp.workerPoolID)
+
+	for _, jobName := range jobNames {
+		queues, err := redisJobQueues(conn, namespace, jobName)
+		if err != nil {
+			return false, err
+		}
+		for _, poolID := range poolIDs {
+			queues = append(queues, redisKeyJobsInProgress(namespace, poolID, jobName))
+		}
+		if pending, err := redisAnyListNonEmpty(conn, queues); err != nil || pending {
+			return pending, err
+		}
+	}
+
+	return false, nil
+}
+
+// redisJobQueues returns the keys of the lists a job name's jobs are queued in: its queue, the list of its groups
+// (which is only empty once their queues are), and the queues of its lanes and named queues.
+func redisJobQueues(conn redis.Conn, namespace, jobName string) ([]string, error) {
+	lanes, err := redis.Strings(conn.Do("HKEYS", redisKeyJobsLanes(namespace, jobName)))
+	if err != nil {
+		return nil, err
+	}
+	namedQueues, err := redis.Strings(conn.Do("SMEMBERS", redisKeyJobsQueues(namespace, jobName)))
+	if err != nil {
+		return nil, err
+	}
+
+	queues := []string{redisKeyJobs(namespace, jobName), redisKeyJobsGroups(namespace, jobName)}
+	for _, lane := range lanes {
+		queues = append(queues, redisKeyJobsLane(namespace, jobName, lane))
+	}
+	for _, queue := range namedQueues {
+		queues = append(queues, redisKeyJobsQueue(namespace, jobName, queue))
+	}
+	return queues, nil
+}
+
+// redisAnyListNonEmpty returns true if any of the lists has elements.
+func redisAnyListNonEmpty(conn redis.Conn, lists []string) (bool, error) {
+	for _, list := range lists {
+		conn.Send("LLEN", list)
+	}
+	if err := conn.Flush(); err != nil {
+		return false, err
+	}
+	nonEmpty := false
+	for range lists {
+		n, err := redis.Int64(conn.Receive())
+		if err != nil {
+			return false, err
+		}
+		nonEmpty = nonEmpty || n > 0
+	}
+	return nonEmpty, nil
+}
+
+func (wp *WorkerPool) currentWorkers() []*worker {
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
+	return append([]*worker(nil), wp.workers...)
+}
+
+func stopWorkers(workers []*worker) {
 	wg := sync.WaitGroup{}
-	for _, w := range wp.workers {
+	for _, w := range workers {
 		wg.Add(1)
 		go func(w *worker) {
-			w.drain()
+			w.stop()
 			wg.Done()
 		}(w)
 	}
 	wg.Wait()
 }
 
-func (wp *WorkerPool) startRequeuers() {
+func (wp *WorkerPool) startRequeuers(ns *poolNamespace) {
+	jobNames := wp.jobNames()
+	ns.retrier = newRequeuer(ns.namespace, wp.pool, redisKeyRetry(ns.namespace), jobNames)
+	ns.scheduler = newRequeuer(ns.namespace, wp.pool, redisKeyScheduled(ns.namespace), jobNames)
+	ns.deadPoolReaper = newDeadPoolReaper(ns.namespace, wp.pool, jobNames)
+	ns.retrier.start()
+	ns.scheduler.start()
+	ns.deadPoolReaper.start()
+}
+
+func (wp *WorkerPool) jobNames() []string {
 	jobNames := make([]string, 0, len(wp.jobTypes))
 	for k := range wp.jobTypes {
 		jobNames = append(jobNames, k)
 	}
-	wp.retrier = newRequeuer(wp.namespace, wp.pool, redisKeyRetry(wp.namespace), jobNames)
-	wp.scheduler = newRequeuer(wp.namespace, wp.pool, redisKeyScheduled(wp.namespace), jobNames)
-	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, jobNames)
-	wp.retrier.start()
-	wp.scheduler.start()
-	wp.deadPoolReaper.start()
+	return jobNames
+}
+
+func (wp *WorkerPool) currentJobTypes() map[string]*jobType {
+	wp.workersMtx.Lock()
+	defer wp.workersMtx.Unlock()
+	return wp.jobTypes
 }
 
 func (wp *WorkerPool) workerIDs() []string {
+	return wp.namespaceWorkerIDs(0)
+}
+
+// namespaceWorkerIDs returns the IDs of the workers in the i-th namespace of the pool.
+func (wp *WorkerPool) namespaceWorkerIDs(i int) []string {
 	wids := make([]string, 0, len(wp.workers))
 	for _, w := range wp.workers {
-		wids = append(wids, w.workerID)
+		wids = append(wids, w.all()[i].workerID)
 	}
 	sort.Strings(wids)
 	return wids
 }
 
-func (wp *WorkerPool) writeKnownJobsToRedis() {
+func (wp *WorkerPool) writeKnownJobsToRedis(jobTypes map[string]*jobType) {
+	if len(jobTypes) == 0 {
+		return
+	}
+
+	conn := wp.pool.Get()
+	defer conn.Close()
+	for _, ns := range wp.namespaces {
+		key := redisKeyKnownJobs(ns.namespace)
+		jobNames := make([]interface{}, 0, len(jobTypes)+1)
+		jobNames = append(jobNames, key)
+		for k := range jobTypes {
+			jobNames = append(jobNames, k)
+		}
+
+		if _, err := conn.Do("SADD", jobNames...); err != nil {
+			logError("write_known_jobs", err)
+		}
+	}
+}
+
+func (wp *WorkerPool) writeConcurrencyControlsToRedis() {
 	if len(wp.jobTypes) == 0 {
 		return
 	}
 
 	conn := wp.pool.Get()
 	defer conn.Close()
-	key := redisKeyKnownJobs(wp.namespace)
-	jobNames := make([]interface{}, 0, len(wp.jobTypes)+1)
-	jobNames = append(jobNames, key)
-	for k := range wp.jobTypes {
-		jobNames = append(jobNames, k)
+	for _, ns := range wp.namespaces {
+		for jobName, jobType := range wp.jobTypes {
+			if _, err := conn.Do("SET", redisKeyJobsConcurrency(ns.namespace, jobName), jobType.MaxConcurrency); err != nil {
+				logError("write_concurrency_controls_max_concurrency", err)
+			}
+			if _, err := conn.Do("SET", redisKeyJobsGroupConcurrency(ns.namespace, jobName), jobType.MaxGroupConcurrency); err != nil {
+				logError("write_concurrency_controls_max_group_concurrency", err)
+			}
+		}
 	}
+}
 
-	if _, err := conn.Do("SADD", jobNames...); err != nil {
-		logError("write_known_jobs", err)
+// writeUniqueOptionsToRedis lets enqueuers know about the UniqueMode and UniqueTTL of each job type. The options are
+// shared by every worker pool of the namespace, so the last pool to start wins; replacing the options another pool
+// wrote is logged, since pools that disagree about them usually mean a deploy is under way or a pool is misconfigured.
+func (wp *WorkerPool) writeUniqueOptionsToRedis() {
+	if len(wp.jobTypes) == 0 {
+		return
+	}
+
+	conn := wp.pool.Get()
+	defer conn.Close()
+	for _, ns := range wp.namespaces {
+		for jobName, jobType := range wp.jobTypes {
+			key := redisKeyJobsUniqueOptions(ns.namespace, jobName)
+			options := map[string]string{}
+			if jobType.UniqueMode != "" || jobType.UniqueTTL >= time.Second {
+				options["mode"] = string(jobType.UniqueMode)
+			}
+			// Without a TTL of its own, the job type falls back on the default one.
+			if ttl := int64(jobType.UniqueTTL / time.Second); ttl > 0 {
+				options["ttl"] = fmt.Sprint(ttl)
+			}
+
+			stored, err := redis.StringMap(conn.Do("HGETALL", key))
+			if err != nil {
+				logError("write_unique_options.hgetall", err)
+				continue
+			}
+			if len(stored) > 0 && !reflect.DeepEqual(stored, options) {
+				logError("write_unique_options.overwrite", fmt.Errorf("unique options of %s changed from %v to %v, another worker pool disagrees about them", jobName, stored, options))
+			}
+
+			conn.Send("MULTI")
+			conn.Send("DEL", key)
+			if len(options) > 0 {
+				args := redis.Args{key}
+				for field, value := range options {
+					args = args.Add(field, value)
+				}
+				conn.Send("HMSET", args...)
+			}
+			if _, err := conn.Do("EXEC"); err != nil {
+				logError("write_unique_options", err)
+			}
+		}
 	}
 }
 
-func (wp *WorkerPool) writeConcurrencyControlsToRedis() {
+// writeRateLimitsToRedis writes the RateLimit of each job type next to the state of its token bucket, where the fetch
+// script picks it up.
+func (wp *WorkerPool) writeRateLimitsToRedis() {
+	if len(wp.jobTypes) == 0 {
+		return
+	}
+
+	conn := wp.pool.Get()
+	defer conn.Close()
+	for _, ns := range wp.namespaces {
+		for jobName, jobType := range wp.jobTypes {
+			key := redisKeyJobsRateLimit(ns.namespace, jobName)
+			rl := jobType.RateLimit
+			var err error
+			if rl.Limit == 0 {
+				_, err = conn.Do("DEL", key)
+			} else {
+				_, err = conn.Do("HMSET", key, "limit", rl.Limit, "per", int64(rl.Per/time.Millisecond), "burst", rl.Burst)
+			}
+			if err != nil {
+				logError("write_rate_limits", err)
+			}
+		}
+	}
+}
+
+// writePrioritiesToRedis writes the priority and lanes of each job type, and the named queues the pool fetches from.
+// Enqueuers only push jobs onto lanes that are written here, and the web UI shows the priorities.
+func (wp *WorkerPool) writePrioritiesToRedis() {
 	if len(wp.jobTypes) == 0 {
 		return
 	}
 
 	conn := wp.pool.Get()
 	defer conn.Close()
-	for jobName, jobType := range wp.jobTypes {
-		if _, err := conn.Do("SET", redisKeyJobsConcurrency(wp.namespace, jobName), jobType.MaxConcurrency); err != nil {
-			logError("write_concurrency_controls_max_concurrency", err)
+	for _, ns := range wp.namespaces {
+		for jobName, jobType := range wp.jobTypes {
+			if _, err := conn.Do("SET", redisKeyJobsPriority(ns.namespace, jobName), jobType.Priority); err != nil {
+				logError("write_priorities", err)
+			}
+
+			// Lanes are merged into the ones other pools wrote, so that pools with different lanes, eg during a deploy,
+			// don't remove each other's. Client.DeleteLane removes a lane that's no longer used.
+			if len(jobType.Lanes) > 0 {
+				args := []interface{}{redisKeyJobsLanes(ns.namespace, jobName)}
+				for lane, priority := range jobType.Lanes {
+					args = append(args, lane, priority)
+				}
+				if _, err := conn.Do("HMSET", args...); err != nil {
+					logError("write_priorities.lanes", err)
+				}
+			}
+
+			// Named queues are listed so that they show up before anything is enqueued to them.
+			if len(wp.queues) > 0 {
+				args := []interface{}{redisKeyJobsQueues(ns.namespace, jobName)}
+				for queue := range wp.queues {
+					args = append(args, queue)
+				}
+				if _, err := conn.Do("SADD", args...); err != nil {
+					logError("write_priorities.queues", err)
+				}
+			}
 		}
 	}
 }
@@ -459,5 +1133,29 @@ func applyDefaultsAndValidate(jobOpts JobOptions) JobOptions {
 		panic("work: JobOptions.Priority must be between 1 and 100000")
 	}
 
+	for lane, priority := range jobOpts.Lanes {
+		if lane == "" || priority == 0 || priority > 100000 {
+			panic("work: JobOptions.Lanes needs lane names and priorities between 1 and 100000")
+		}
+	}
+
+	if err := jobOpts.UniqueMode.validate(); err != nil {
+		panic(err)
+	}
+
+	// The lease is renewed every third of it, which a lease that short can't keep up with.
+	if jobOpts.NoOverlapLease != 0 && jobOpts.NoOverlapLease < time.Second {
+		panic("work: JobOptions.NoOverlapLease must be at least a second")
+	}
+
+	if jobOpts.RateLimit.Limit > 0 {
+		if jobOpts.RateLimit.Per < time.Millisecond {
+			panic("work: JobOptions.RateLimit.Per must be at least a millisecond")
+		}
+		if jobOpts.RateLimit.Burst == 0 {
+			jobOpts.RateLimit.Burst = jobOpts.RateLimit.Limit
+		}
+	}
+
 	return jobOpts
 }
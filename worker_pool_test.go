@@ -2,8 +2,11 @@ package work
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -206,6 +209,372 @@ func TestWorkerPoolPauseSingleThreadedJobs(t *testing.T) {
 	assert.EqualValues(t, 0, hgetInt64(pool, redisKeyJobsLockInfo(ns, job1), wp.workerPoolID))
 }
 
+func TestWorkerPoolSetConcurrency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	wp := setupTestWorkerPool(pool, ns, job1, 2, JobOptions{Priority: 1})
+	wp.SetConcurrency(3)
+	assert.EqualValues(t, 3, wp.Concurrency())
+	assert.Len(t, wp.workers, 3)
+
+	wp.Start()
+	// Let the first beat go out, so that it doesn't overwrite the ones below.
+	for readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))["concurrency"] == "" {
+		time.Sleep(time.Millisecond)
+	}
+	heartbeat := func() map[string]string {
+		wp.namespaces[0].heartbeater.heartbeat()
+		return readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))
+	}
+	assert.Equal(t, "3", heartbeat()["concurrency"])
+
+	wp.SetConcurrency(5)
+	h := heartbeat()
+	assert.Equal(t, "5", h["concurrency"])
+	assert.Equal(t, strings.Join(wp.workerIDs(), ","), h["worker_ids"])
+	assert.Len(t, strings.Split(h["worker_ids"], ","), 5)
+
+	// Removed workers finish their jobs, and the rest keep processing.
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 10; i++ {
+		_, err := enqueuer.Enqueue(job1, Q{"sleep": 5})
+		assert.NoError(t, err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	wp.SetConcurrency(1)
+	h = heartbeat()
+	assert.Equal(t, "1", h["concurrency"])
+	assert.Equal(t, wp.workers[0].workerID, h["worker_ids"])
+
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+}
+
+func TestWorkerPoolAddRemoveJobWhileStarted(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1, job2 := "work", "job1", "job2"
+	wp := setupTestWorkerPool(pool, ns, job1, 2, JobOptions{Priority: 1})
+	deleteQueue(pool, ns, job2)
+	cleanKeyspace(ns, pool)
+	wp.Start()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job2, Q{"sleep": 1})
+	assert.NoError(t, err)
+	time.Sleep(30 * time.Millisecond)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job2)))
+
+	var processed int64
+	wp.JobWithOptions(job2, JobOptions{Priority: 1, MaxConcurrency: 3}, func(*Job) error {
+		atomic.AddInt64(&processed, 1)
+		return nil
+	})
+	wp.Drain()
+	assert.EqualValues(t, 1, atomic.LoadInt64(&processed))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job2)))
+	assert.EqualValues(t, 3, getInt64(pool, redisKeyJobsConcurrency(ns, job2)))
+	assert.Contains(t, knownJobs(pool, redisKeyKnownJobs(ns)), job2)
+	assert.Equal(t, "job1,job2", readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))["job_names"])
+	wp.namespaces[0].scheduler.mtx.Lock()
+	assert.Contains(t, wp.namespaces[0].scheduler.redisRequeueArgs, redisKeyJobs(ns, job2))
+	wp.namespaces[0].scheduler.mtx.Unlock()
+	assert.Contains(t, wp.namespaces[0].deadPoolReaper.curJobTypes, job2)
+
+	wp.RemoveJob(job2)
+	wp.RemoveJob("unknown")
+	_, err = enqueuer.Enqueue(job2, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"sleep": 1})
+	assert.NoError(t, err)
+	wp.Drain()
+	assert.EqualValues(t, 1, atomic.LoadInt64(&processed))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job2)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.Equal(t, "job1", readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))["job_names"])
+	wp.namespaces[0].retrier.mtx.Lock()
+	assert.NotContains(t, wp.namespaces[0].retrier.redisRequeueArgs, redisKeyJobs(ns, job2))
+	wp.namespaces[0].retrier.mtx.Unlock()
+
+	// Scheduled jobs that come due once the handler is removed are moved to dead by the pool's scheduler.
+	deleteRetryAndDead(pool, ns)
+	conn := pool.Get()
+	_, err = conn.Do("DEL", redisKeyScheduled(ns))
+	conn.Close()
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn(job2, 0, nil)
+	assert.NoError(t, err)
+	wp.namespaces[0].scheduler.drain()
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, "unknown job when requeueing", job.LastErr)
+
+	wp.Stop()
+}
+
+func TestWorkerPoolStopWithContext(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	deleteQueue(pool, ns, job1)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	started := make(chan *Job, 2)
+	unblock := make(chan struct{})
+	var restarted int32
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job(job1, func(job *Job) error {
+		started <- job
+		if atomic.LoadInt32(&restarted) == 1 {
+			return nil
+		} else if job.ArgBool("stuck") {
+			<-unblock // ignores its context
+		} else {
+			<-job.Context().Done()
+		}
+		return nil
+	})
+	wp.Start()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"stuck": true})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"stuck": false})
+	assert.NoError(t, err)
+	jobs := []*Job{<-started, <-started}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, wp.StopWithContext(ctx))
+
+	for _, job := range jobs {
+		assert.Error(t, job.Context().Err())
+	}
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+	assert.EqualValues(t, 0, hgetInt64(pool, redisKeyJobsLockInfo(ns, job1), wp.workerPoolID))
+	assert.False(t, redisInSet(pool, redisKeyWorkerPools(ns), wp.workerPoolID))
+
+	// The stuck job returning doesn't terminate it a second time.
+	close(unblock)
+	time.Sleep(20 * time.Millisecond)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+
+	// The pool can be started again, and stops gracefully when jobs finish in time.
+	atomic.StoreInt32(&restarted, 1)
+	wp.Start()
+	<-started
+	<-started
+	assert.NoError(t, wp.StopWithContext(context.Background()))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+}
+
+func TestWorkerPoolStopDoesntBlockOtherCalls(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	deleteQueue(pool, ns, job1)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	started := make(chan struct{}, 1)
+	unblock := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job(job1, func(job *Job) error {
+		started <- struct{}{}
+		<-unblock
+		return nil
+	})
+	wp.Start()
+
+	_, err := NewEnqueuer(ns, pool).Enqueue(job1, nil)
+	assert.NoError(t, err)
+	<-started
+
+	stopped := make(chan struct{})
+	go func() {
+		wp.Stop()
+		close(stopped)
+	}()
+	for wp.Status().Started {
+		time.Sleep(time.Millisecond)
+	}
+
+	// While Stop waits for the job, the pool can still be changed.
+	done := make(chan struct{})
+	go func() {
+		wp.SetConcurrency(2)
+		wp.Quiet()
+		wp.Resume()
+		wp.Status()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("blocked by Stop")
+	}
+
+	select {
+	case <-stopped:
+		t.Fatal("Stop didn't wait for the job")
+	default:
+	}
+	close(unblock)
+	<-stopped
+	assert.EqualValues(t, 2, wp.Concurrency())
+	assert.Equal(t, 2, len(wp.Status().Workers))
+}
+
+func TestWorkerPoolDrainContext(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	wp := setupTestWorkerPool(pool, ns, job1, 1, JobOptions{Priority: 1})
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		_, err := enqueuer.Enqueue(job1, Q{"sleep": 20})
+		assert.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, wp.DrainContext(ctx))
+	assert.True(t, listSize(pool, redisKeyJobs(ns, job1)) > 0)
+
+	// The worker isn't stuck on the abandoned drain.
+	assert.NoError(t, wp.DrainContext(context.Background()))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+}
+
+func TestWorkerPoolSetConcurrencyWhileDraining(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	wp := setupTestWorkerPool(pool, ns, job1, 2, JobOptions{Priority: 1})
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 20; i++ {
+		_, err := enqueuer.Enqueue(job1, Q{"sleep": 20})
+		assert.NoError(t, err)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		wp.Drain()
+		close(drained)
+	}()
+	for !wp.Status().Draining {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The drain doesn't hold SetConcurrency up, nor wait for the removed worker.
+	wp.SetConcurrency(1)
+	select {
+	case <-drained:
+		t.Fatal("drained before SetConcurrency returned")
+	default:
+	}
+	assert.EqualValues(t, 1, wp.Concurrency())
+	<-drained
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+}
+
+func TestWorkerPoolDrainJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1, job2 := "work", "job1", "job2"
+	wp := setupTestWorkerPool(pool, ns, job1, 2, JobOptions{Priority: 1})
+	deleteQueue(pool, ns, job2)
+	deletePausedAndLockedKeys(ns, job2, pool)
+	wp.JobWithOptions(job2, JobOptions{Priority: 1, Lanes: map[string]uint{"high": 1}}, (*TestContext).SleepyJob)
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"sleep": 30})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueToLane(job2, "high", Q{"sleep": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueForGroup(job2, "g", Q{"sleep": 1})
+	assert.NoError(t, err)
+
+	// Draining job2 doesn't wait for job1.
+	assert.NoError(t, pauseJobs(ns, job1, pool))
+	wp.DrainJobs(job2)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsLane(ns, job2, "high")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsGroups(ns, job2)))
+
+	// Paused jobs are waited for.
+	_, err = enqueuer.Enqueue(job1, Q{"sleep": 1})
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, wp.DrainJobsContext(ctx, job1))
+
+	assert.NoError(t, unpauseJobs(ns, job1, pool))
+	assert.NoError(t, wp.DrainJobsContext(context.Background(), job1, job2))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+}
+
+func TestWorkerPoolNamespaces(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns1, ns2, job1 := "work", "tenant2", "job1"
+	cleanKeyspace(ns1, pool)
+	cleanKeyspace(ns2, pool)
+
+	var processed []string
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns1, pool, WorkerPoolOptions{Namespaces: []string{ns2}})
+	wp.JobWithOptions(job1, JobOptions{Priority: 1, MaxFails: 1}, func(job *Job) error {
+		processed = append(processed, job.ArgString("ns"))
+		if job.ArgBool("fail") {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	})
+
+	// A busy namespace doesn't starve the other one.
+	for _, ns := range []string{ns1, ns2} {
+		enqueuer := NewEnqueuer(ns, pool)
+		for i := 0; i < 3; i++ {
+			_, err := enqueuer.Enqueue(job1, Q{"ns": ns})
+			assert.NoError(t, err)
+		}
+	}
+	_, err := NewEnqueuer(ns2, pool).Enqueue(job1, Q{"ns": ns2, "fail": true})
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	assert.Equal(t, []string{ns1, ns2, ns1, ns2, ns1, ns2, ns2}, processed)
+
+	// Each namespace has its own heartbeat, and keeps its own failed jobs.
+	for i, ns := range []string{ns1, ns2} {
+		assert.True(t, redisInSet(pool, redisKeyWorkerPools(ns), wp.workerPoolID))
+		h := readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))
+		assert.Equal(t, strings.Join(wp.namespaceWorkerIDs(i), ","), h["worker_ids"])
+		assert.Equal(t, job1, h["job_names"])
+		assert.Contains(t, knownJobs(pool, redisKeyKnownJobs(ns)), job1)
+	}
+	assert.NotEqual(t, wp.namespaceWorkerIDs(0), wp.namespaceWorkerIDs(1))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns1)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns2)))
+	assert.NoError(t, wp.DrainJobsContext(context.Background(), job1))
+
+	wp.Stop()
+	for _, ns := range []string{ns1, ns2} {
+		assert.False(t, redisInSet(pool, redisKeyWorkerPools(ns), wp.workerPoolID))
+	}
+
+	assert.Panics(t, func() {
+		NewWorkerPoolWithOptions(TestContext{}, 1, ns1, pool, WorkerPoolOptions{Namespaces: []string{ns2, ns1}})
+	})
+}
+
 // Test Helpers
 func (t *TestContext) SleepyJob(job *Job) error {
 	sleepTime := time.Duration(job.ArgInt64("sleep"))
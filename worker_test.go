@@ -2,6 +2,7 @@ package work
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"sync/atomic"
 	"testing"
@@ -301,6 +302,543 @@ func TestWorkerDead(t *testing.T) {
 	assert.True(t, (nowEpochSeconds()-job.FailedAt) <= 2)
 }
 
+func TestWorkerDeadRetention(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	// Some old dead jobs and some recent ones
+	insertDeadJobWithErr(ns, pool, job1, nil, "old", 1425263409-7200)
+	insertDeadJobWithErr(ns, pool, job1, nil, "old", 1425263409-3601)
+	for i := int64(0); i < 5; i++ {
+		insertDeadJobWithErr(ns, pool, job1, nil, "recent", 1425263409-60+i)
+	}
+
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 0},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			return fmt.Errorf("sorry kid1")
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, nil)
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.deadJobRetention = DeadJobRetention{MaxAge: time.Hour, MaxCount: 4}
+	w.start()
+	w.drain()
+	w.stop()
+
+	// 2 jobs were too old, then 2 more were past the max count.
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyDead(ns)))
+
+	client := NewClient(ns, pool)
+	jobs, _, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	if assert.Equal(t, 4, len(jobs)) {
+		assert.EqualValues(t, 1425263409-58, jobs[0].DiedAt)
+		assert.Equal(t, "sorry kid1", jobs[3].LastErr)
+	}
+
+	stats, err := client.Stats()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, stats.DeadJobsTrimmed)
+}
+
+func TestWorkerNoOverlap(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	conn := pool.Get()
+	defer conn.Close()
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	ran := map[string]int{}
+	handler := func(job *Job) error {
+		ran[job.Name+job.ArgString("key")]++
+		return nil
+	}
+	jobTypes := map[string]*jobType{
+		"skipped": {Name: "skipped", JobOptions: JobOptions{Priority: 1, NoOverlap: true}, IsGeneric: true, GenericHandler: handler},
+		"delayed": {Name: "delayed", JobOptions: JobOptions{Priority: 1, NoOverlap: true, NoOverlapDelay: 30 * time.Second}, IsGeneric: true, GenericHandler: handler},
+		"keyed":   {Name: "keyed", JobOptions: JobOptions{Priority: 1, NoOverlap: true, NoOverlapByKey: true}, IsGeneric: true, GenericHandler: handler},
+	}
+
+	// Another pool is running each job type.
+	for name := range jobTypes {
+		_, err := conn.Do("SET", redisKeyJobsNoOverlap(ns, name), "other")
+		assert.NoError(t, err)
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("skipped", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("delayed", Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUniqueByKey("keyed", Q{"key": "1"}, Q{"key": "1"})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUniqueByKey("keyed", Q{"key": "2"}, Q{"key": "2"})
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	// Jobs locked by unique key don't care about the lock on the job name.
+	assert.Equal(t, map[string]int{"keyed1": 1, "keyed2": 1}, ran)
+	for name := range jobTypes {
+		assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, name)))
+		assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", name)))
+		assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, name)))
+	}
+
+	score, job := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.Equal(t, "delayed", job.Name)
+	assert.EqualValues(t, 1, job.ArgInt64("a"))
+	assert.EqualValues(t, 1425263409+30, score)
+
+	client := NewClient(ns, pool)
+	stats, err := client.Stats()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, stats.NoOverlapSkipped)
+	assert.EqualValues(t, 1, stats.NoOverlapDelayed)
+
+	// Once the other pool is done, jobs run and release the lock.
+	_, err = conn.Do("DEL", redisKeyJobsNoOverlap(ns, "skipped"))
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("skipped", nil)
+	assert.NoError(t, err)
+
+	w = newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, 1, ran["skipped"])
+	exists, err := redis.Bool(conn.Do("EXISTS", redisKeyJobsNoOverlap(ns, "skipped")))
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestWorkerNoOverlapSubSecondDelay(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	conn := pool.Get()
+	defer conn.Close()
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	handler := func(job *Job) error { return nil }
+	jobTypes := map[string]*jobType{
+		"delayed": {Name: "delayed", JobOptions: JobOptions{Priority: 1, NoOverlap: true, NoOverlapDelay: 200 * time.Millisecond}, IsGeneric: true, GenericHandler: handler},
+	}
+	_, err := conn.Do("SET", redisKeyJobsNoOverlap(ns, "delayed"), "other")
+	assert.NoError(t, err)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err = enqueuer.Enqueue("delayed", nil)
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	score, job := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.Equal(t, "delayed", job.Name)
+	assert.EqualValues(t, 1425263409+1, score)
+}
+
+func TestWorkerNoOverlapLeaseValidation(t *testing.T) {
+	wp := NewWorkerPool(TestContext{}, 1, "work", newTestPool(":6379"))
+	handler := func(job *Job) error { return nil }
+
+	assert.Panics(t, func() {
+		wp.JobWithOptions("short", JobOptions{NoOverlap: true, NoOverlapLease: 2 * time.Nanosecond}, handler)
+	})
+	assert.Panics(t, func() {
+		wp.JobWithOptions("negative", JobOptions{NoOverlap: true, NoOverlapLease: -time.Second}, handler)
+	})
+	assert.NotPanics(t, func() {
+		wp.JobWithOptions("default", JobOptions{NoOverlap: true}, handler)
+		wp.JobWithOptions("second", JobOptions{NoOverlap: true, NoOverlapLease: time.Second}, handler)
+	})
+}
+
+func TestWorkerRateLimit(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{RateLimit: RateLimit{Limit: 2, Per: time.Minute}}, func(job *Job) error { return nil })
+	wp.writeRateLimitsToRedis()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+
+	fetched := func() int {
+		w := newWorker(ns, "1", pool, tstCtxType, nil, wp.jobTypes, nil)
+		n := 0
+		for {
+			job, err := w.fetchJob()
+			assert.NoError(t, err)
+			if job == nil {
+				return n
+			}
+			n++
+		}
+	}
+
+	// The bucket starts out full.
+	assert.Equal(t, 2, fetched())
+
+	queues, err := NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) && assert.NotNil(t, queues[0].RateLimit) {
+		assert.EqualValues(t, 2, queues[0].RateLimit.Burst)
+		assert.EqualValues(t, 0, queues[0].RateLimit.Tokens)
+		assert.EqualValues(t, 30000, queues[0].RateLimit.HeldBackMs)
+	}
+
+	// A token comes in every 30 seconds.
+	setNowEpochSecondsMock(1425263439)
+	assert.Equal(t, 1, fetched())
+
+	// The bucket never holds more than the burst.
+	setNowEpochSecondsMock(1425263409 + 3600)
+	assert.Equal(t, 2, fetched())
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Without a rate limit, the bucket is ignored.
+	wp.jobTypes["wat"].RateLimit = RateLimit{}
+	wp.writeRateLimitsToRedis()
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 3, fetched())
+
+	queues, err = NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.Nil(t, queues[0].RateLimit)
+	}
+}
+
+func TestWorkerGroups(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	conn := pool.Get()
+	defer conn.Close()
+
+	jobTypes := map[string]*jobType{
+		"wat": {Name: "wat", JobOptions: JobOptions{Priority: 1, MaxGroupConcurrency: 1}, IsGeneric: true, GenericHandler: func(job *Job) error { return nil }},
+	}
+	_, err := conn.Do("SET", redisKeyJobsGroupConcurrency(ns, "wat"), 1)
+	assert.NoError(t, err)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		_, err := enqueuer.EnqueueForGroup("wat", "a", Q{"i": i})
+		assert.NoError(t, err)
+	}
+	_, err = enqueuer.EnqueueForGroup("wat", "b", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueForGroup("wat", "c", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	queues, err := NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.EqualValues(t, 8, queues[0].Count)
+		assert.EqualValues(t, 3, queues[0].Groups)
+	}
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+
+	// The groups and the jobs without a group take turns, but only one job of each group runs at a time.
+	fetched := map[string]*Job{}
+	for i := 0; i < 4; i++ {
+		job, err := w.fetchJob()
+		assert.NoError(t, err)
+		if assert.NotNil(t, job) {
+			fetched[job.Group] = job
+		}
+	}
+	assert.Equal(t, 4, len(fetched))
+	job, err := w.fetchJob()
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	assert.EqualValues(t, 1, hgetInt64(pool, redisKeyJobsGroupLock(ns, "wat"), "a"))
+	assert.EqualValues(t, 1, hgetInt64(pool, redisKeyJobsGroupLockInfo(ns, "wat"), "1:a"))
+	w.removeJobFromInProgress(fetched["a"], terminateOnly)
+	w.removeJobFromInProgress(fetched["b"], terminateOnly)
+	exists, err := redis.Bool(conn.Do("HEXISTS", redisKeyJobsGroupLock(ns, "wat"), "a"))
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	job, err = w.fetchJob()
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, "a", job.Group)
+	}
+
+	// Jobs of a dead worker pool go back to their group.
+	reaper := newDeadPoolReaper(ns, pool, nil)
+	assert.NoError(t, reaper.requeueInProgressJobs("1", []string{"wat"}))
+	assert.NoError(t, reaper.cleanStaleLockInfo("1", []string{"wat"}))
+	assert.EqualValues(t, 4, listSize(pool, redisKeyJobsGroup(ns, "wat", "a")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsGroup(ns, "wat", "c")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	groupLocks, err := redis.Int64Map(conn.Do("HGETALL", redisKeyJobsGroupLock(ns, "wat")))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{}, groupLocks)
+
+	// Scheduled jobs are moved to their group's queue.
+	_, err = enqueuer.EnqueueInForGroup("wat", "d", 0, nil)
+	assert.NoError(t, err)
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat"})
+	re.process()
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsGroup(ns, "wat", "d")))
+	groups, err := redis.Strings(conn.Do("LRANGE", redisKeyJobsGroups(ns, "wat"), 0, -1))
+	assert.NoError(t, err)
+	sort.Strings(groups)
+	assert.Equal(t, []string{"a", "c", "d"}, groups)
+}
+
+func TestWorkerGroupsWithUngroupedBacklog(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	jobTypes := map[string]*jobType{
+		"wat": {Name: "wat", JobOptions: JobOptions{Priority: 1}, IsGeneric: true, GenericHandler: func(job *Job) error { return nil }},
+	}
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 1000; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+	for _, group := range []string{"a", "b"} {
+		for i := 0; i < 3; i++ {
+			_, err := enqueuer.EnqueueForGroup("wat", group, Q{"i": i})
+			assert.NoError(t, err)
+		}
+	}
+
+	// A backlog of jobs without a group doesn't hold up the groups: it takes a turn after each round of them.
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	var order []string
+	for i := 0; i < 12; i++ {
+		job, err := w.fetchJob()
+		assert.NoError(t, err)
+		if assert.NotNil(t, job) {
+			order = append(order, job.Group)
+		}
+	}
+	assert.Equal(t, []string{"a", "b", "", "a", "b", "", "a", "", "b", "", "", ""}, order)
+	assert.EqualValues(t, 1000-6, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestWorkerLanes(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{StrictPriority: true})
+	handler := func(job *Job) error { return nil }
+	wp.JobWithOptions("wat", JobOptions{Priority: 2, Lanes: map[string]uint{"high": 10, "low": 1}}, handler)
+	wp.JobWithOptions("other", JobOptions{Priority: 5}, handler)
+	wp.writePrioritiesToRedis()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueToLane("wat", "low", Q{"i": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", Q{"i": 2})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueToLane("wat", "unknown", Q{"i": 3})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("other", Q{"i": 4})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueToLane("wat", "high", Q{"i": 5})
+	assert.NoError(t, err)
+
+	queues, err := NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	var summary []string
+	for _, q := range queues {
+		summary = append(summary, fmt.Sprintf("%s:%s:%d:%d", q.JobName, q.Lane, q.Priority, q.Count))
+	}
+	assert.Equal(t, []string{"other::5:1", "wat::2:2", "wat:high:10:1", "wat:low:1:1"}, summary)
+
+	// In strict mode, queues are drained in order of priority.
+	var order []int64
+	for {
+		job, err := wp.workers[0].fetchJob()
+		assert.NoError(t, err)
+		if job == nil {
+			break
+		}
+		order = append(order, job.ArgInt64("i"))
+	}
+	assert.Equal(t, []int64{5, 4, 2, 3, 1}, order)
+
+	// Scheduled jobs are moved to their lane.
+	_, err = enqueuer.EnqueueInToLane("wat", "high", 0, nil)
+	assert.NoError(t, err)
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat", "other"})
+	re.process()
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsLane(ns, "wat", "high")))
+}
+
+func TestWorkerLanesOfSeveralPools(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	handler := func(job *Job) error { return nil }
+	wp1 := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp1.JobWithOptions("wat", JobOptions{Priority: 2, Lanes: map[string]uint{"high": 10}}, handler)
+	wp1.writePrioritiesToRedis()
+	wp2 := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp2.JobWithOptions("wat", JobOptions{Priority: 2, Lanes: map[string]uint{"low": 1}}, handler)
+	wp2.writePrioritiesToRedis()
+	wp3 := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp3.JobWithOptions("wat", JobOptions{Priority: 2}, handler)
+	wp3.writePrioritiesToRedis()
+
+	// Pools don't remove each other's lanes.
+	conn := pool.Get()
+	defer conn.Close()
+	lanes, err := redis.Int64Map(conn.Do("HGETALL", redisKeyJobsLanes(ns, "wat")))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"high": 10, "low": 1}, lanes)
+
+	// Deleting a lane moves its jobs onto the job queue.
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err = enqueuer.Enqueue("wat", Q{"i": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueToLane("wat", "low", Q{"i": 2})
+	assert.NoError(t, err)
+
+	moved, err := NewClient(ns, pool).DeleteLane("wat", "low")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, moved)
+	lanes, err = redis.Int64Map(conn.Do("HGETALL", redisKeyJobsLanes(ns, "wat")))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"high": 10}, lanes)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsLane(ns, "wat", "low")))
+
+	var order []int64
+	for {
+		job, err := wp3.workers[0].fetchJob()
+		assert.NoError(t, err)
+		if job == nil {
+			break
+		}
+		order = append(order, job.ArgInt64("i"))
+	}
+	assert.Equal(t, []int64{1, 2}, order)
+}
+
+func TestWorkerNamedQueues(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	handler := func(job *Job) error { return nil }
+	newPool := func(opts WorkerPoolOptions) *WorkerPool {
+		wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, opts)
+		wp.JobWithOptions("wat", JobOptions{Priority: 5}, handler)
+		wp.writePrioritiesToRedis()
+		return wp
+	}
+	fetchAll := func(wp *WorkerPool) []int64 {
+		var order []int64
+		for {
+			job, err := wp.workers[0].fetchJob()
+			assert.NoError(t, err)
+			if job == nil {
+				return order
+			}
+			order = append(order, job.ArgInt64("i"))
+		}
+	}
+
+	defaultPool := newPool(WorkerPoolOptions{})
+	subscribedPool := newPool(WorkerPoolOptions{StrictPriority: true, Queues: map[string]uint{"enterprise": 10}})
+	dedicatedPool := newPool(WorkerPoolOptions{Queues: map[string]uint{"enterprise": 1}, QueuesOnly: true})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueTo("enterprise", "wat", Q{"i": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", Q{"i": 2})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueTo("", "wat", Q{"i": 3})
+	assert.NoError(t, err)
+
+	queues, err := NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	var summary []string
+	for _, q := range queues {
+		summary = append(summary, fmt.Sprintf("%s:%s:%d:%d", q.JobName, q.Queue, q.Priority, q.Count))
+	}
+	assert.Equal(t, []string{"wat::5:2", "wat:enterprise:0:1"}, summary)
+
+	// Pools that aren't subscribed to a queue leave its jobs alone.
+	assert.Equal(t, []int64{2, 3}, fetchAll(defaultPool))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsQueue(ns, "wat", "enterprise")))
+
+	_, err = enqueuer.Enqueue("wat", Q{"i": 4})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 4}, fetchAll(subscribedPool))
+
+	_, err = enqueuer.Enqueue("wat", Q{"i": 5})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueTo("enterprise", "wat", Q{"i": 6})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{6}, fetchAll(dedicatedPool))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Scheduled and retried jobs go back to their queue.
+	_, err = enqueuer.EnqueueInTo("enterprise", "wat", 0, nil)
+	assert.NoError(t, err)
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat"})
+	re.process()
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsQueue(ns, "wat", "enterprise")))
+
+	assert.Panics(t, func() {
+		NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{Queues: map[string]uint{"enterprise": 0}})
+	})
+	assert.Panics(t, func() {
+		NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{QueuesOnly: true})
+	})
+}
+
 func TestWorkersPaused(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"